@@ -0,0 +1,213 @@
+// benchdiff 对比两份 go test -bench 的输出, 标记超过阈值的性能回退
+//
+//	go test -run '^$' -bench Matrix -count 5 ./pkg/demo/v10 > old.txt
+//	go test -run '^$' -bench Matrix -count 5 ./pkg/many > new.txt
+//	go run ./cmd/benchdiff -threshold 10 old.txt new.txt
+//
+// 同名基准多次运行时取平均值, 存在回退时以状态码 1 退出
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// result 某个基准的平均结果
+type result struct {
+	nsPerOp     float64
+	allocsPerOp float64
+	hasAllocs   bool
+}
+
+// delta 同名基准在两份结果中的对比
+type delta struct {
+	name       string
+	old, new   result
+	nsPct      float64
+	regression bool
+}
+
+// procSuffix 基准名末尾形如 -8 的后缀, 可能是 GOMAXPROCS 后缀, 也可能是子基准名本身的一部分
+var procSuffix = regexp.MustCompile(`-\d+$`)
+
+func main() {
+	threshold := flag.Float64("threshold", 10, "ns/op 增加超过该百分比视为回退")
+	allocSlack := flag.Float64("allocs", 0, "allocs/op 允许增加的次数")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: benchdiff [flags] old.txt new.txt\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	old, err := parseFile(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	cur, err := parseFile(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	deltas, onlyOld, onlyNew := compare(old, cur, *threshold, *allocSlack)
+	regressions := report(os.Stdout, deltas, onlyOld, onlyNew)
+	if regressions > 0 {
+		fmt.Printf("\n%d regression(s) beyond %.1f%%\n", regressions, *threshold)
+		os.Exit(1)
+	}
+}
+
+func parseFile(path string) (map[string]result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parse(f)
+}
+
+// parse 读取基准输出, 同名基准取平均值
+func parse(r io.Reader) (map[string]result, error) {
+	type sum struct {
+		ns, allocs float64
+		n, nAllocs int
+	}
+	sums := map[string]*sum{}
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		name := fields[0]
+		s := sums[name]
+		if s == nil {
+			s = &sum{}
+			sums[name] = s
+		}
+		// 字段格式: 名称 迭代次数 (数值 单位)...
+		for i := 2; i+1 < len(fields); i += 2 {
+			val, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("parse %q: %w", sc.Text(), err)
+			}
+			switch fields[i+1] {
+			case "ns/op":
+				s.ns += val
+				s.n++
+			case "allocs/op":
+				s.allocs += val
+				s.nAllocs++
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	suffix := commonProcSuffix(sums)
+	results := make(map[string]result, len(sums))
+	for name, s := range sums {
+		if s.n == 0 {
+			continue
+		}
+		name = strings.TrimSuffix(name, suffix)
+		res := result{nsPerOp: s.ns / float64(s.n)}
+		if s.nAllocs > 0 {
+			res.allocsPerOp = s.allocs / float64(s.nAllocs)
+			res.hasAllocs = true
+		}
+		results[name] = res
+	}
+	return results, nil
+}
+
+// commonProcSuffix 返回所有基准名共有的 GOMAXPROCS 后缀, 没有时返回空字符串
+// GOMAXPROCS=1 时 go test 不追加后缀, 只有全部名称以同一个 -N 结尾才视为后缀,
+// 避免去掉 BenchmarkX/size-1 这类子基准名本身的数字
+func commonProcSuffix[V any](names map[string]V) string {
+	suffix := ""
+	for name := range names {
+		s := procSuffix.FindString(name)
+		if s == "" || suffix != "" && s != suffix {
+			return ""
+		}
+		suffix = s
+	}
+	return suffix
+}
+
+// compare 按名称对比两份结果, 返回共同基准的差异以及只存在于一侧的基准名
+func compare(old, cur map[string]result, threshold, allocSlack float64) (deltas []delta, onlyOld, onlyNew []string) {
+	for name, o := range old {
+		n, ok := cur[name]
+		if !ok {
+			onlyOld = append(onlyOld, name)
+			continue
+		}
+		d := delta{name: name, old: o, new: n}
+		if o.nsPerOp > 0 {
+			d.nsPct = (n.nsPerOp - o.nsPerOp) / o.nsPerOp * 100
+		}
+		d.regression = d.nsPct > threshold ||
+			(o.hasAllocs && n.hasAllocs && n.allocsPerOp > o.allocsPerOp+allocSlack)
+		deltas = append(deltas, d)
+	}
+	for name := range cur {
+		if _, ok := old[name]; !ok {
+			onlyNew = append(onlyNew, name)
+		}
+	}
+
+	sort.Slice(deltas, func(i, j int) bool { return deltas[i].name < deltas[j].name })
+	sort.Strings(onlyOld)
+	sort.Strings(onlyNew)
+	return deltas, onlyOld, onlyNew
+}
+
+// report 输出对比表格, 返回回退的数量
+func report(w io.Writer, deltas []delta, onlyOld, onlyNew []string) int {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "name\told ns/op\tnew ns/op\tdelta\told allocs\tnew allocs\t")
+	regressions := 0
+	for _, d := range deltas {
+		mark := ""
+		if d.regression {
+			mark = "REGRESSION"
+			regressions++
+		}
+		fmt.Fprintf(tw, "%s\t%.2f\t%.2f\t%+.1f%%\t%s\t%s\t%s\n",
+			d.name, d.old.nsPerOp, d.new.nsPerOp, d.nsPct,
+			formatAllocs(d.old), formatAllocs(d.new), mark)
+	}
+	tw.Flush()
+
+	for _, name := range onlyOld {
+		fmt.Fprintf(w, "only in old: %s\n", name)
+	}
+	for _, name := range onlyNew {
+		fmt.Fprintf(w, "only in new: %s\n", name)
+	}
+	return regressions
+}
+
+func formatAllocs(r result) string {
+	if !r.hasAllocs {
+		return "-"
+	}
+	return strconv.FormatFloat(r.allocsPerOp, 'f', -1, 64)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const oldOutput = `goos: linux
+pkg: github.com/lwmacct/250300-go-mod-many/pkg/demo/v10
+BenchmarkMatrix/int/string-8     1000000    100.0 ns/op    8 B/op    1 allocs/op
+BenchmarkMatrix/int/string-8     1000000    120.0 ns/op    8 B/op    1 allocs/op
+BenchmarkMatrix/string_int/int-8 1000000     50.0 ns/op    0 B/op    0 allocs/op
+BenchmarkMatrix/bool/int-8       1000000     10.0 ns/op    0 B/op    0 allocs/op
+BenchmarkOnlyOld-8               1000000     10.0 ns/op
+PASS
+`
+
+const newOutput = `BenchmarkMatrix/int/string-4     1000000     60.0 ns/op    0 B/op    0 allocs/op
+BenchmarkMatrix/string_int/int-4 1000000     80.0 ns/op    0 B/op    0 allocs/op
+BenchmarkMatrix/bool/int-4       1000000     10.5 ns/op   16 B/op    1 allocs/op
+BenchmarkOnlyNew-4               1000000     10.0 ns/op
+`
+
+func TestParse(t *testing.T) {
+	res, err := parse(strings.NewReader(oldOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, ok := res["BenchmarkMatrix/int/string"]
+	if !ok {
+		t.Fatalf("缺少 BenchmarkMatrix/int/string, 得到 %v", res)
+	}
+	if r.nsPerOp != 110 || r.allocsPerOp != 1 || !r.hasAllocs {
+		t.Errorf("BenchmarkMatrix/int/string = %+v, 期望平均 110 ns/op, 1 allocs/op", r)
+	}
+	if r := res["BenchmarkOnlyOld"]; r.hasAllocs {
+		t.Errorf("BenchmarkOnlyOld 不应包含 allocs/op")
+	}
+}
+
+func TestParseProcSuffix(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   []string
+	}{
+		{"统一的GOMAXPROCS后缀", "BenchmarkA/n-1-8 1 1.0 ns/op\nBenchmarkB-8 1 1.0 ns/op\n", []string{"BenchmarkA/n-1", "BenchmarkB"}},
+		{"GOMAXPROCS=1时保留子基准数字", "BenchmarkA/n-1 1 1.0 ns/op\nBenchmarkA/n-10 1 1.0 ns/op\n", []string{"BenchmarkA/n-1", "BenchmarkA/n-10"}},
+		{"部分名称无后缀", "BenchmarkA/n-4 1 1.0 ns/op\nBenchmarkB 1 1.0 ns/op\n", []string{"BenchmarkA/n-4", "BenchmarkB"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := parse(strings.NewReader(tt.output))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != len(tt.want) {
+				t.Fatalf("parse() = %v, 期望 %v", res, tt.want)
+			}
+			for _, name := range tt.want {
+				if _, ok := res[name]; !ok {
+					t.Errorf("缺少 %s, 得到 %v", name, res)
+				}
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	old, _ := parse(strings.NewReader(oldOutput))
+	cur, _ := parse(strings.NewReader(newOutput))
+
+	deltas, onlyOld, onlyNew := compare(old, cur, 10, 0)
+	got := map[string]bool{}
+	for _, d := range deltas {
+		got[d.name] = d.regression
+	}
+
+	want := map[string]bool{
+		"BenchmarkMatrix/int/string":     false, // 变快
+		"BenchmarkMatrix/string_int/int": true,  // ns/op +60%
+		"BenchmarkMatrix/bool/int":       true,  // +5% 但多了一次分配
+	}
+	for name, reg := range want {
+		if got[name] != reg {
+			t.Errorf("%s regression = %v, 期望 %v", name, got[name], reg)
+		}
+	}
+	if len(onlyOld) != 1 || onlyOld[0] != "BenchmarkOnlyOld" {
+		t.Errorf("onlyOld = %v", onlyOld)
+	}
+	if len(onlyNew) != 1 || onlyNew[0] != "BenchmarkOnlyNew" {
+		t.Errorf("onlyNew = %v", onlyNew)
+	}
+
+	var buf bytes.Buffer
+	if n := report(&buf, deltas, onlyOld, onlyNew); n != 2 {
+		t.Errorf("report() = %d 个回退, 期望 2\n%s", n, buf.String())
+	}
+}
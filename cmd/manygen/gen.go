@@ -0,0 +1,371 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// kind 描述一种支持的基础类型
+type kind struct {
+	category string // 作为源类型时的分类, 决定各 base 转换的写法
+	base     string // 作为目标类型时先转换成的基础类型
+}
+
+// kinds 支持的类型, 与 many.To 中基础类型的分支一一对应
+var kinds = map[string]kind{
+	"bool":    {"bool", "bool"},
+	"string":  {"string", "string"},
+	"int":     {"signed", "int64"},
+	"int8":    {"signed", "int64"},
+	"int16":   {"signed", "int64"},
+	"int32":   {"signed", "int64"},
+	"int64":   {"signed", "int64"},
+	"uint":    {"unsigned", "uint64"},
+	"uint8":   {"unsigned", "uint64"},
+	"uint16":  {"unsigned", "uint64"},
+	"uint32":  {"unsigned", "uint64"},
+	"uint64":  {"uint64", "uint64"},
+	"float32": {"float32", "float64"},
+	"float64": {"float64", "float64"},
+}
+
+// baseBodies 各分类的源值转换为 base 类型的函数体, 与 pkg/many/to.go 中的 toXxx 保持一致
+var baseBodies = map[string]map[string]string{
+	"int64": {
+		"bool": "if v {\nreturn 1\n}\nreturn 0",
+		"string": `if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+return i
+}
+if f, err := strconv.ParseFloat(v, 64); err == nil {
+return floatToInt64(f)
+}
+return 0`,
+		"signed":   "return int64(v)",
+		"unsigned": "return int64(v)",
+		"uint64":   "if v > math.MaxInt64 {\nreturn math.MaxInt64\n}\nreturn int64(v)",
+		"float32":  "return floatToInt64(float64(v))",
+		"float64":  "return floatToInt64(v)",
+	},
+	"uint64": {
+		"bool": "if v {\nreturn 1\n}\nreturn 0",
+		"string": `if u, err := strconv.ParseUint(v, 10, 64); err == nil {
+return u
+}
+if f, err := strconv.ParseFloat(v, 64); err == nil {
+return floatToUint64(f)
+}
+return 0`,
+		"signed":   "if v < 0 {\nreturn 0\n}\nreturn uint64(v)",
+		"unsigned": "return uint64(v)",
+		"uint64":   "return v",
+		"float32":  "return floatToUint64(float64(v))",
+		"float64":  "return floatToUint64(v)",
+	},
+	"float64": {
+		"bool":     "if v {\nreturn 1\n}\nreturn 0",
+		"string":   "if f, err := strconv.ParseFloat(v, 64); err == nil {\nreturn f\n}\nreturn 0",
+		"signed":   "return float64(v)",
+		"unsigned": "return float64(v)",
+		"uint64":   "return float64(v)",
+		"float32":  "return float64(v)",
+		"float64":  "return v",
+	},
+	"bool": {
+		"bool": "return v",
+		"string": `switch v {
+case "1", "t", "T", "true", "TRUE", "True", "yes", "YES", "Yes", "y", "Y", "on", "ON", "On":
+return true
+default:
+return false
+}`,
+		"signed":   "return v == 1",
+		"unsigned": "return v == 1",
+		"uint64":   "return v == 1",
+		"float32":  "return v == 1.0",
+		"float64":  "return v == 1.0",
+	},
+	"string": {
+		"bool":     "if v {\nreturn \"true\"\n}\nreturn \"false\"",
+		"string":   "return v",
+		"signed":   "return strconv.FormatInt(int64(v), 10)",
+		"unsigned": "return strconv.FormatUint(uint64(v), 10)",
+		"uint64":   "return strconv.FormatUint(v, 10)",
+		"float32":  "return formatFloat(float64(v), 32)",
+		"float64":  "return formatFloat(v, 64)",
+	},
+}
+
+// helpers 生成代码中按需输出的辅助函数, 与 pkg/many 中的同名函数逻辑一致
+var helpers = []struct {
+	name string
+	body string
+}{
+	{"floatToInt64", `// floatToInt64 截断小数部分, 超出 int64 范围时取边界值, NaN 返回 0
+func floatToInt64(f float64) int64 {
+switch {
+case f != f:
+return 0
+case f >= math.MaxInt64:
+return math.MaxInt64
+case f <= math.MinInt64:
+return math.MinInt64
+default:
+return int64(f)
+}
+}`},
+	{"floatToUint64", `// floatToUint64 截断小数部分, 负数返回 0, 超出 uint64 范围时取最大值, NaN 返回 0
+func floatToUint64(f float64) uint64 {
+switch {
+case f != f || f < 0:
+return 0
+case f >= math.MaxUint64:
+return math.MaxUint64
+default:
+return uint64(f)
+}
+}`},
+	{"formatFloat", `// formatFloat 整数形式不带小数点, 否则使用能解析回原值的最短小数形式
+func formatFloat(f float64, bitSize int) string {
+if math.Floor(f) == f && f >= math.MinInt64 && f < math.MaxInt64 {
+return strconv.FormatInt(int64(f), 10)
+}
+return strconv.FormatFloat(f, 'f', -1, bitSize)
+}`},
+	{"overflowsString", `// overflowsString 判断字符串的数值是否超出 [lo, hi], 无法解析时返回 false
+func overflowsString(s string, lo int64, hi uint64) bool {
+if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+return overflowsInt(i, lo, hi)
+}
+if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+return u > hi
+}
+f, err := strconv.ParseFloat(s, 64)
+if err != nil && f == 0 {
+return false
+}
+return overflowsFloat(f, lo, hi)
+}`},
+	{"overflowsInt", `// overflowsInt 判断 i 是否超出 [lo, hi]
+func overflowsInt(i int64, lo int64, hi uint64) bool {
+if i < 0 {
+return i < lo
+}
+return uint64(i) > hi
+}`},
+	{"overflowsFloat", `// overflowsFloat 判断 f 截断后是否超出 [lo, hi], NaN 视为越界
+func overflowsFloat(f float64, lo int64, hi uint64) bool {
+if math.IsNaN(f) {
+return true
+}
+f = math.Trunc(f)
+return f < float64(lo) || f >= float64(hi)+1
+}`},
+	{"overflowsFloat32", `// overflowsFloat32 有限的 f 转换为 float32 后变为 ±Inf 视为越界
+func overflowsFloat32(f float64) bool {
+return !math.IsInf(f, 0) && math.IsInf(float64(float32(f)), 0)
+}`},
+}
+
+// ranges 整数目标类型的范围, 用于 E 函数的越界检查
+var ranges = map[string][2]string{
+	"int":    {"math.MinInt", "math.MaxInt"},
+	"int8":   {"math.MinInt8", "math.MaxInt8"},
+	"int16":  {"math.MinInt16", "math.MaxInt16"},
+	"int32":  {"math.MinInt32", "math.MaxInt32"},
+	"int64":  {"math.MinInt64", "math.MaxInt64"},
+	"uint":   {"0", "math.MaxUint"},
+	"uint8":  {"0", "math.MaxUint8"},
+	"uint16": {"0", "math.MaxUint16"},
+	"uint32": {"0", "math.MaxUint32"},
+	"uint64": {"0", "math.MaxUint64"},
+}
+
+// overflowCheck 返回 E 函数中判断 v 越界的表达式, 不可能越界时返回空串
+func overflowCheck(src, dst string) string {
+	cat := kinds[src].category
+	if dst == "float32" {
+		switch cat {
+		case "float64":
+			return "overflowsFloat32(v)"
+		case "string":
+			return "overflowsFloat32(stringAsFloat64(v))"
+		}
+		return ""
+	}
+	r, ok := ranges[dst]
+	if !ok {
+		return ""
+	}
+	switch cat {
+	case "signed":
+		return fmt.Sprintf("overflowsInt(int64(v), %s, %s)", r[0], r[1])
+	case "unsigned", "uint64":
+		return fmt.Sprintf("uint64(v) > %s", r[1])
+	case "float32", "float64":
+		return fmt.Sprintf("overflowsFloat(float64(v), %s, %s)", r[0], r[1])
+	case "string":
+		return fmt.Sprintf("overflowsString(v, %s, %s)", r[0], r[1])
+	}
+	return ""
+}
+
+// zeroLiterals 各 base 类型的零值写法
+var zeroLiterals = map[string]string{
+	"bool":   "false",
+	"string": `""`,
+}
+
+// samples 一致性测试中各源类型的输入
+var samples = map[string]string{
+	"bool":    "true, false",
+	"string":  `"", "0", "1", "-1", "2", "123", "-123", "123.45", "-0.5", "1e3", "abc", "true", "yes", "on", "off", "300", "-300", "65536", "99999999999999999999", "18446744073709551615", "1e20", "-1e20", "3.5e38", "1e400"`,
+	"int":     "0, 1, -1, 2, 300, -300, math.MaxInt, math.MinInt",
+	"int8":    "0, 1, -1, 2, math.MaxInt8, math.MinInt8",
+	"int16":   "0, 1, -1, 2, 300, -300, math.MaxInt16, math.MinInt16",
+	"int32":   "0, 1, -1, 2, 300, -300, math.MaxInt32, math.MinInt32",
+	"int64":   "0, 1, -1, 2, 300, -300, math.MaxInt64, math.MinInt64",
+	"uint":    "0, 1, 2, 300, math.MaxUint",
+	"uint8":   "0, 1, 2, math.MaxUint8",
+	"uint16":  "0, 1, 2, 300, math.MaxUint16",
+	"uint32":  "0, 1, 2, 300, math.MaxUint32",
+	"uint64":  "0, 1, 2, 300, math.MaxInt64, math.MaxUint64",
+	"float32": "0, 1, -1, 0.5, 2.25, 123.45, -123.45, 1e10, -1e10",
+	"float64": "0, 1, -1, 0.5, 2.25, 123.45, -123.45, 1e10, -1e10, 1e20, 3.5e38, 1e300, math.Inf(1), math.Inf(-1)",
+}
+
+// exportedName 导出函数名, 如 StringToInt64
+func exportedName(src, dst string) string {
+	return title(src) + "To" + title(dst)
+}
+
+// baseName 内部基础转换函数名, 如 stringAsInt64
+func baseName(src, base string) string {
+	return src + "As" + title(base)
+}
+
+func title(s string) string {
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// renderCode 生成转换函数源码
+func renderCode(cfg config) []byte {
+	var body bytes.Buffer
+	needBase := map[string]bool{}
+
+	for _, src := range cfg.Sources {
+		for _, dst := range cfg.Targets {
+			base := kinds[dst].base
+			needBase[src+"/"+base] = true
+			name := exportedName(src, dst)
+
+			expr := baseName(src, base) + "(v)"
+			if dst != base {
+				expr = dst + "(" + expr + ")"
+			}
+			zero := zeroLiterals[base]
+			if zero == "" {
+				zero = "0"
+			}
+			isZero := "r == " + zero
+			if base == "bool" {
+				isZero = "!r"
+			}
+
+			fmt.Fprintf(&body, "// %s 按 many.To[%s] 的规则将 %s 转换为 %s\n", name, dst, src, dst)
+			fmt.Fprintf(&body, "func %s(v %s) %s {\nreturn %s\n}\n\n", name, src, dst, expr)
+			fmt.Fprintf(&body, "// %sE 按 many.ToE[%s] 的规则将 %s 转换为 %s, 结果为零值或越界时返回错误\n", name, dst, src, dst)
+			fmt.Fprintf(&body, "func %sE(v %s) (%s, error) {\n", name, src, dst)
+			fmt.Fprintf(&body, "r := %s(v)\nif %s {\nreturn %s, errors.New(%q)\n}\n", name, isZero, zero, "cannot convert "+src+" to "+dst)
+			if check := overflowCheck(src, dst); check != "" {
+				fmt.Fprintf(&body, "if %s {\nreturn %s, fmt.Errorf(\"value %%v overflows %s\", v)\n}\n", check, zero, dst)
+			}
+			body.WriteString("return r, nil\n}\n\n")
+		}
+	}
+
+	// 基础转换函数按源类型、base 的顺序输出, 保证结果稳定
+	for _, src := range cfg.Sources {
+		for _, base := range []string{"bool", "string", "int64", "uint64", "float64"} {
+			if !needBase[src+"/"+base] {
+				continue
+			}
+			fmt.Fprintf(&body, "func %s(v %s) %s {\n%s\n}\n\n", baseName(src, base), src, base, baseBodies[base][kinds[src].category])
+		}
+	}
+
+	// 辅助函数之间可能互相引用, 反复扫描直到不再新增
+	used := map[string]bool{}
+	for changed := true; changed; {
+		changed = false
+		for _, h := range helpers {
+			if !used[h.name] && strings.Contains(body.String(), h.name+"(") {
+				used[h.name] = true
+				changed = true
+				body.WriteString(h.body + "\n\n")
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by manygen; DO NOT EDIT.\n\npackage %s\n\n", cfg.Package)
+	writeImports(&buf, body.String(), []string{"errors", "fmt", "math", "strconv"})
+	buf.Write(body.Bytes())
+	return buf.Bytes()
+}
+
+// renderTest 生成与 many.To / many.ToE 对比的一致性测试
+func renderTest(cfg config) []byte {
+	var body bytes.Buffer
+	body.WriteString("func TestConformance(t *testing.T) {\n")
+	for _, src := range cfg.Sources {
+		fmt.Fprintf(&body, "samples%s := []%s{%s}\n", title(src), src, samples[src])
+	}
+	for _, src := range cfg.Sources {
+		for _, dst := range cfg.Targets {
+			name := exportedName(src, dst)
+			fmt.Fprintf(&body, `
+t.Run(%q, func(t *testing.T) {
+for _, v := range samples%s {
+if got, want := %s(v), many.To[%s](v); got != want {
+t.Errorf("%s(%%v) = %%v, many.To = %%v", v, got, want)
+}
+got, gotErr := %sE(v)
+want, wantErr := many.ToE[%s](v)
+if got != want || (gotErr == nil) != (wantErr == nil) {
+t.Errorf("%sE(%%v) = %%v, %%v; many.ToE = %%v, %%v", v, got, gotErr, want, wantErr)
+}
+}
+})
+`, name, title(src), name, dst, name, name, dst, name)
+		}
+	}
+	body.WriteString("}\n")
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by manygen; DO NOT EDIT.\n\npackage %s\n\nimport (\n", cfg.Package)
+	if strings.Contains(body.String(), "math.") {
+		buf.WriteString("\"math\"\n")
+	}
+	buf.WriteString("\"testing\"\n\n\"github.com/lwmacct/250300-go-mod-many/pkg/many\"\n)\n\n")
+	buf.Write(body.Bytes())
+	return buf.Bytes()
+}
+
+// writeImports 只导入 body 中实际用到的包
+func writeImports(buf *bytes.Buffer, body string, pkgs []string) {
+	var used []string
+	for _, p := range pkgs {
+		if strings.Contains(body, p+".") {
+			used = append(used, p)
+		}
+	}
+	if len(used) == 0 {
+		return
+	}
+	buf.WriteString("import (\n")
+	for _, p := range used {
+		fmt.Fprintf(buf, "%q\n", p)
+	}
+	buf.WriteString(")\n\n")
+}
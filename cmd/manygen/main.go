@@ -0,0 +1,129 @@
+// manygen 为指定的 源类型 × 目标类型 生成专用的转换函数, 语义与 many.To / many.ToE 一致,
+// 但不经过泛型与 any 的分发, 适合放在热点路径上
+//
+//	//go:generate go run github.com/lwmacct/250300-go-mod-many/cmd/manygen -pkg conv -sources string,int -targets int64,bool -o conv_gen.go -test
+//
+// 每一对类型生成两个函数, 如 StringToInt64(string) int64 与 StringToInt64E(string) (int64, error)
+// 也可以用 -config 指定 JSON 配置文件, 命令行参数优先于配置文件
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+)
+
+// config 生成配置
+type config struct {
+	Package string   `json:"package"`
+	Output  string   `json:"output"`
+	Sources []string `json:"sources"`
+	Targets []string `json:"targets"`
+	Test    bool     `json:"test"` // 同时生成与 many.To 对比的一致性测试
+}
+
+func main() {
+	var (
+		cfgPath = flag.String("config", "", "JSON 配置文件")
+		pkg     = flag.String("pkg", "", "生成代码的包名")
+		out     = flag.String("o", "", "输出文件, 为空时输出到标准输出")
+		sources = flag.String("sources", "", "逗号分隔的源类型")
+		targets = flag.String("targets", "", "逗号分隔的目标类型")
+		test    = flag.Bool("test", false, "同时生成一致性测试 <输出文件>_test.go")
+	)
+	flag.Parse()
+
+	var cfg config
+	if *cfgPath != "" {
+		data, err := os.ReadFile(*cfgPath)
+		if err != nil {
+			fatal(err)
+		}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			fatal(fmt.Errorf("parse %s: %w", *cfgPath, err))
+		}
+	}
+	if *pkg != "" {
+		cfg.Package = *pkg
+	}
+	if *out != "" {
+		cfg.Output = *out
+	}
+	if *sources != "" {
+		cfg.Sources = splitList(*sources)
+	}
+	if *targets != "" {
+		cfg.Targets = splitList(*targets)
+	}
+	if *test {
+		cfg.Test = true
+	}
+	if cfg.Package == "" {
+		cfg.Package = os.Getenv("GOPACKAGE")
+	}
+
+	code, testCode, err := generate(cfg)
+	if err != nil {
+		fatal(err)
+	}
+
+	if cfg.Output == "" {
+		os.Stdout.Write(code)
+		return
+	}
+	if err := os.WriteFile(cfg.Output, code, 0o644); err != nil {
+		fatal(err)
+	}
+	if cfg.Test {
+		testPath := strings.TrimSuffix(cfg.Output, ".go") + "_test.go"
+		if err := os.WriteFile(testPath, testCode, 0o644); err != nil {
+			fatal(err)
+		}
+	}
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "manygen:", err)
+	os.Exit(1)
+}
+
+// generate 返回格式化后的转换代码与一致性测试代码
+func generate(cfg config) (code, testCode []byte, err error) {
+	if cfg.Package == "" {
+		return nil, nil, fmt.Errorf("package name is required")
+	}
+	if len(cfg.Sources) == 0 || len(cfg.Targets) == 0 {
+		return nil, nil, fmt.Errorf("at least one source and one target type are required")
+	}
+	for _, t := range append(append([]string{}, cfg.Sources...), cfg.Targets...) {
+		if _, ok := kinds[t]; !ok {
+			return nil, nil, fmt.Errorf("unsupported type %q", t)
+		}
+	}
+
+	src, err := format.Source(renderCode(cfg))
+	if err != nil {
+		return nil, nil, fmt.Errorf("format generated code: %w", err)
+	}
+	if !cfg.Test {
+		return src, nil, nil
+	}
+	test, err := format.Source(renderTest(cfg))
+	if err != nil {
+		return nil, nil, fmt.Errorf("format generated test: %w", err)
+	}
+	return src, test, nil
+}
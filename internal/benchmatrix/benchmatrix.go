@@ -0,0 +1,71 @@
+// Package benchmatrix 提供 pkg/many 与 pkg/demo/v10 共用的基准测试矩阵,
+// 两边的子基准名称一致, 便于用 cmd/benchdiff 直接对比
+package benchmatrix
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Source 一种源值
+type Source struct {
+	Name  string
+	Value any
+}
+
+// Sources 覆盖各种源类型的输入, 数值类字符串分为整数、浮点与布尔三种
+var Sources = []Source{
+	{"nil", nil},
+	{"bool", true},
+	{"int", 123456},
+	{"int8", int8(-8)},
+	{"int16", int16(1600)},
+	{"int32", int32(-32000)},
+	{"int64", int64(1) << 40},
+	{"uint", uint(123456)},
+	{"uint8", uint8(200)},
+	{"uint16", uint16(1600)},
+	{"uint32", uint32(32000)},
+	{"uint64", uint64(1) << 40},
+	{"float32", float32(3.25)},
+	{"float64", 123.45},
+	{"string_int", "123456"},
+	{"string_float", "123.45"},
+	{"string_bool", "true"},
+	{"json.Number", json.Number("123.45")},
+	{"bytes", []byte("123456")},
+	{"struct", struct{ Name string }{"bench"}},
+}
+
+// Target 一种目标类型的转换函数
+type Target struct {
+	Name string
+	Fn   func(v any)
+}
+
+// NewTarget 将 To[T] 之类的函数包装为 Target, 结果写入闭包内的变量以免被编译器优化掉
+func NewTarget[T any](name string, fn func(any) T) Target {
+	var sink T
+	return Target{
+		Name: name,
+		Fn: func(v any) {
+			sink = fn(v)
+			_ = sink
+		},
+	}
+}
+
+// Run 以 源/目标 为子基准名运行整个矩阵, 并报告内存分配
+func Run(b *testing.B, targets []Target) {
+	for _, src := range Sources {
+		for _, dst := range targets {
+			b.Run(src.Name+"/"+dst.Name, func(b *testing.B) {
+				v, fn := src.Value, dst.Fn
+				b.ReportAllocs()
+				for b.Loop() {
+					fn(v)
+				}
+			})
+		}
+	}
+}
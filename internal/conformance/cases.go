@@ -0,0 +1,115 @@
+package conformance
+
+import (
+	"encoding/json"
+	"math"
+)
+
+// person 结构体输入, 转字符串时应得到 JSON
+type person struct {
+	Name string
+	Age  int
+}
+
+// Cases 黄金表, 期望值以 pkg/many 的行为为准
+// To 在数值越界时按 Go 的转换规则回绕或取边界值, ToE 对此返回错误
+var Cases = []Case{
+	// 转 bool
+	{"bool/true", true, "bool", true, false},
+	{"bool/false", false, "bool", false, true},
+	{"bool/string_1", "1", "bool", true, false},
+	{"bool/string_true", "true", "bool", true, false},
+	{"bool/string_True", "True", "bool", true, false},
+	{"bool/string_yes", "yes", "bool", true, false},
+	{"bool/string_on", "on", "bool", true, false},
+	{"bool/string_Y", "Y", "bool", true, false},
+	{"bool/string_false", "false", "bool", false, true},
+	{"bool/string_abc", "abc", "bool", false, true},
+	{"bool/int_1", 1, "bool", true, false},
+	{"bool/int_2", 2, "bool", false, true},
+	{"bool/int_-1", -1, "bool", false, true},
+	{"bool/uint8_1", uint8(1), "bool", true, false},
+	{"bool/float_1", 1.0, "bool", true, false},
+	{"bool/float_0.5", 0.5, "bool", false, true},
+	{"bool/json_1", json.Number("1"), "bool", false, true},
+	{"bool/nil", nil, "bool", false, false},
+
+	// 转 string
+	{"string/string", "hello", "string", "hello", false},
+	{"string/empty", "", "string", "", true},
+	{"string/true", true, "string", "true", false},
+	{"string/false", false, "string", "false", false},
+	{"string/int", 123, "string", "123", false},
+	{"string/int_neg", -123, "string", "-123", false},
+	{"string/int64_max", int64(math.MaxInt64), "string", "9223372036854775807", false},
+	{"string/uint64_max", uint64(math.MaxUint64), "string", "18446744073709551615", false},
+	{"string/float_int", 123.0, "string", "123", false},
+	{"string/float_frac", 123.45, "string", "123.45", false},
+	{"string/float_round", 1.005, "string", "1.005", false},
+	{"string/float32", float32(2.5), "string", "2.5", false},
+	{"string/float32_short", float32(0.1), "string", "0.1", false},
+	{"string/json", json.Number("1.50"), "string", "1.50", false},
+	{"string/struct", person{"a", 1}, "string", `{"Name":"a","Age":1}`, false},
+	{"string/nil", nil, "string", "", false},
+
+	// 转有符号整数
+	{"int/int", 123, "int", 123, false},
+	{"int/zero", 0, "int", 0, true},
+	{"int/string", "123", "int", 123, false},
+	{"int/string_neg", "-123", "int", -123, false},
+	{"int/string_float", "123.45", "int", 123, false},
+	{"int/string_exp", "1e3", "int", 1000, false},
+	{"int/string_abc", "abc", "int", 0, true},
+	{"int/string_space", " 1", "int", 0, true},
+	{"int/float", 123.9, "int", 123, false},
+	{"int/float_neg", -123.9, "int", -123, false},
+	{"int/true", true, "int", 1, false},
+	{"int/uint64_max", uint64(math.MaxUint64), "int", math.MaxInt, true},
+	{"int/json_int", json.Number("42"), "int", 42, false},
+	{"int/json_float", json.Number("4.2"), "int", 4, false},
+	{"int/nil", nil, "int", 0, false},
+	{"int8/int", 100, "int8", int8(100), false},
+	{"int8/overflow", 300, "int8", int8(44), true},
+	{"int8/string_overflow", "200", "int8", int8(-56), true},
+	{"int16/string", "-32768", "int16", int16(-32768), false},
+	{"int32/float", 1.5e9, "int32", int32(1500000000), false},
+	{"int64/string_max", "9223372036854775807", "int64", int64(math.MaxInt64), false},
+	{"int64/uint64", uint64(1) << 63, "int64", int64(math.MaxInt64), true},
+
+	// 转无符号整数
+	{"uint/int", 123, "uint", uint(123), false},
+	{"uint/int_neg", -1, "uint", uint(0), true},
+	{"uint/string", "123", "uint", uint(123), false},
+	{"uint/string_neg", "-123", "uint", uint(0), true},
+	{"uint/string_float", "12.7", "uint", uint(12), false},
+	{"uint/float_neg", -1.5, "uint", uint(0), true},
+	{"uint/true", true, "uint", uint(1), false},
+	{"uint8/int", 255, "uint8", uint8(255), false},
+	{"uint8/overflow", 300, "uint8", uint8(44), true},
+	{"uint8/string_overflow", "300", "uint8", uint8(44), true},
+	{"uint16/int8_neg", int8(-5), "uint16", uint16(0), true},
+	{"uint32/float", 4e9, "uint32", uint32(4000000000), false},
+	{"uint64/string_max", "18446744073709551615", "uint64", uint64(math.MaxUint64), false},
+	{"uint64/json", json.Number("7"), "uint64", uint64(7), false},
+
+	// 转浮点数
+	{"float64/int", 123, "float64", 123.0, false},
+	{"float64/string", "123.45", "float64", 123.45, false},
+	{"float64/string_exp", "1.23e2", "float64", 123.0, false},
+	{"float64/string_abc", "abc", "float64", 0.0, true},
+	{"float64/string_nan", "NaN", "float64", math.NaN(), false},
+	{"float64/true", true, "float64", 1.0, false},
+	{"float64/uint64_max", uint64(math.MaxUint64), "float64", float64(math.MaxUint64), false},
+	{"float64/json", json.Number("123.45"), "float64", 123.45, false},
+	{"float64/nil", nil, "float64", 0.0, false},
+	{"float32/string", "1.5", "float32", float32(1.5), false},
+	{"float32/float64", 0.1, "float32", float32(0.1), false},
+	{"float32/overflow", 1e300, "float32", float32(math.Inf(1)), true},
+
+	// 越界的浮点数
+	{"int64/float_huge", 1e300, "int64", int64(math.MaxInt64), true},
+	{"int64/string_huge", "1e20", "int64", int64(math.MaxInt64), true},
+	{"uint64/float_huge", 1e300, "uint64", uint64(math.MaxUint64), true},
+	{"string/float_huge", 1e20, "string", "100000000000000000000", false},
+	{"string/float_inf", math.Inf(1), "string", "+Inf", false},
+}
@@ -0,0 +1,129 @@
+// Package conformance 提供 To 系列实现共用的行为一致性测试
+//
+// Cases 是以 pkg/many 当前行为为准的黄金表, 任何实现只需提供目标类型到 To/ToE 的映射,
+// 即可通过 Check 得到与黄金表的差异, 或通过 Run 在有差异时直接让测试失败
+package conformance
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"text/tabwriter"
+)
+
+// Case 一条黄金用例
+type Case struct {
+	Name    string
+	Input   any
+	Target  string // 目标类型名, 如 "int8"
+	Want    any    // 期望的 To 结果, 类型必须与 Target 一致
+	WantErr bool   // 期望 ToE 返回错误
+}
+
+// Func 某个目标类型的转换函数, 实现不提供 ToE 时 ToE 为 nil, 此时不检查错误
+type Func struct {
+	To  func(v any) any
+	ToE func(v any) (any, error)
+}
+
+// NewFunc 将 To[T] / ToE[T] 包装为 Func, toE 可以为 nil
+func NewFunc[T any](to func(any) T, toE func(any) (T, error)) Func {
+	f := Func{To: func(v any) any { return to(v) }}
+	if toE != nil {
+		f.ToE = func(v any) (any, error) { return toE(v) }
+	}
+	return f
+}
+
+// Impl 一个待测实现
+type Impl struct {
+	Name    string
+	Targets map[string]Func
+	// Skip 不参与检查的用例名到原因的映射, 用于结果由平台决定的用例,
+	// 例如越界的浮点数直接转换为整数, Go 规范未定义其结果, amd64 与 arm64 不同
+	Skip map[string]string
+}
+
+// Divergence 实现与黄金表不一致的一处
+type Divergence struct {
+	Case   Case
+	Got    any
+	GotErr error
+	Reason string
+}
+
+// Check 用全部黄金用例检查 impl, 返回所有差异
+func Check(impl Impl) []Divergence {
+	var divs []Divergence
+	for _, c := range Cases {
+		if _, skip := impl.Skip[c.Name]; skip {
+			continue
+		}
+		fn, ok := impl.Targets[c.Target]
+		if !ok {
+			divs = append(divs, Divergence{Case: c, Reason: "unsupported target"})
+			continue
+		}
+
+		got := fn.To(c.Input)
+		if !equal(got, c.Want) {
+			divs = append(divs, Divergence{Case: c, Got: got, Reason: "To value"})
+			continue
+		}
+		if fn.ToE == nil {
+			continue
+		}
+
+		gotE, err := fn.ToE(c.Input)
+		switch {
+		case (err != nil) != c.WantErr:
+			divs = append(divs, Divergence{Case: c, Got: gotE, GotErr: err, Reason: "ToE error"})
+		case err == nil && !equal(gotE, c.Want):
+			divs = append(divs, Divergence{Case: c, Got: gotE, Reason: "ToE value"})
+		}
+	}
+	return divs
+}
+
+// Run 检查 impl, 每处差异都会让测试失败
+func Run(t testing.TB, impl Impl) {
+	t.Helper()
+	if divs := Check(impl); len(divs) > 0 {
+		t.Errorf("%s diverges from the golden table in %d case(s):\n%s", impl.Name, len(divs), Report(divs))
+	}
+}
+
+// Report 将差异格式化为表格, 每行一处差异, 结果稳定, 可作为黄金文件保存
+func Report(divs []Divergence) string {
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "case\ttarget\tinput\twant\tgot\treason")
+	for _, d := range divs {
+		want := fmt.Sprintf("%#v", d.Case.Want)
+		if d.Case.WantErr {
+			want += " (err)"
+		}
+		got := "-"
+		if d.Reason != "unsupported target" {
+			got = fmt.Sprintf("%#v", d.Got)
+		}
+		if d.GotErr != nil {
+			got += " (err)"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%#v\t%s\t%s\t%s\n", d.Case.Name, d.Case.Target, d.Case.Input, want, got, d.Reason)
+	}
+	tw.Flush()
+	return b.String()
+}
+
+// equal 比较结果, NaN 与 NaN 视为相等
+func equal(got, want any) bool {
+	if g, ok := got.(float64); ok {
+		if w, ok := want.(float64); ok && math.IsNaN(g) && math.IsNaN(w) {
+			return true
+		}
+	}
+	return reflect.DeepEqual(got, want)
+}
@@ -0,0 +1,3551 @@
+// Code generated by manygen; DO NOT EDIT.
+
+package fastconv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// BoolToBool 按 many.To[bool] 的规则将 bool 转换为 bool
+func BoolToBool(v bool) bool {
+	return boolAsBool(v)
+}
+
+// BoolToBoolE 按 many.ToE[bool] 的规则将 bool 转换为 bool, 结果为零值或越界时返回错误
+func BoolToBoolE(v bool) (bool, error) {
+	r := BoolToBool(v)
+	if !r {
+		return false, errors.New("cannot convert bool to bool")
+	}
+	return r, nil
+}
+
+// BoolToString 按 many.To[string] 的规则将 bool 转换为 string
+func BoolToString(v bool) string {
+	return boolAsString(v)
+}
+
+// BoolToStringE 按 many.ToE[string] 的规则将 bool 转换为 string, 结果为零值或越界时返回错误
+func BoolToStringE(v bool) (string, error) {
+	r := BoolToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert bool to string")
+	}
+	return r, nil
+}
+
+// BoolToInt 按 many.To[int] 的规则将 bool 转换为 int
+func BoolToInt(v bool) int {
+	return int(boolAsInt64(v))
+}
+
+// BoolToIntE 按 many.ToE[int] 的规则将 bool 转换为 int, 结果为零值或越界时返回错误
+func BoolToIntE(v bool) (int, error) {
+	r := BoolToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to int")
+	}
+	return r, nil
+}
+
+// BoolToInt8 按 many.To[int8] 的规则将 bool 转换为 int8
+func BoolToInt8(v bool) int8 {
+	return int8(boolAsInt64(v))
+}
+
+// BoolToInt8E 按 many.ToE[int8] 的规则将 bool 转换为 int8, 结果为零值或越界时返回错误
+func BoolToInt8E(v bool) (int8, error) {
+	r := BoolToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to int8")
+	}
+	return r, nil
+}
+
+// BoolToInt16 按 many.To[int16] 的规则将 bool 转换为 int16
+func BoolToInt16(v bool) int16 {
+	return int16(boolAsInt64(v))
+}
+
+// BoolToInt16E 按 many.ToE[int16] 的规则将 bool 转换为 int16, 结果为零值或越界时返回错误
+func BoolToInt16E(v bool) (int16, error) {
+	r := BoolToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to int16")
+	}
+	return r, nil
+}
+
+// BoolToInt32 按 many.To[int32] 的规则将 bool 转换为 int32
+func BoolToInt32(v bool) int32 {
+	return int32(boolAsInt64(v))
+}
+
+// BoolToInt32E 按 many.ToE[int32] 的规则将 bool 转换为 int32, 结果为零值或越界时返回错误
+func BoolToInt32E(v bool) (int32, error) {
+	r := BoolToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to int32")
+	}
+	return r, nil
+}
+
+// BoolToInt64 按 many.To[int64] 的规则将 bool 转换为 int64
+func BoolToInt64(v bool) int64 {
+	return boolAsInt64(v)
+}
+
+// BoolToInt64E 按 many.ToE[int64] 的规则将 bool 转换为 int64, 结果为零值或越界时返回错误
+func BoolToInt64E(v bool) (int64, error) {
+	r := BoolToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to int64")
+	}
+	return r, nil
+}
+
+// BoolToUint 按 many.To[uint] 的规则将 bool 转换为 uint
+func BoolToUint(v bool) uint {
+	return uint(boolAsUint64(v))
+}
+
+// BoolToUintE 按 many.ToE[uint] 的规则将 bool 转换为 uint, 结果为零值或越界时返回错误
+func BoolToUintE(v bool) (uint, error) {
+	r := BoolToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to uint")
+	}
+	return r, nil
+}
+
+// BoolToUint8 按 many.To[uint8] 的规则将 bool 转换为 uint8
+func BoolToUint8(v bool) uint8 {
+	return uint8(boolAsUint64(v))
+}
+
+// BoolToUint8E 按 many.ToE[uint8] 的规则将 bool 转换为 uint8, 结果为零值或越界时返回错误
+func BoolToUint8E(v bool) (uint8, error) {
+	r := BoolToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to uint8")
+	}
+	return r, nil
+}
+
+// BoolToUint16 按 many.To[uint16] 的规则将 bool 转换为 uint16
+func BoolToUint16(v bool) uint16 {
+	return uint16(boolAsUint64(v))
+}
+
+// BoolToUint16E 按 many.ToE[uint16] 的规则将 bool 转换为 uint16, 结果为零值或越界时返回错误
+func BoolToUint16E(v bool) (uint16, error) {
+	r := BoolToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to uint16")
+	}
+	return r, nil
+}
+
+// BoolToUint32 按 many.To[uint32] 的规则将 bool 转换为 uint32
+func BoolToUint32(v bool) uint32 {
+	return uint32(boolAsUint64(v))
+}
+
+// BoolToUint32E 按 many.ToE[uint32] 的规则将 bool 转换为 uint32, 结果为零值或越界时返回错误
+func BoolToUint32E(v bool) (uint32, error) {
+	r := BoolToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to uint32")
+	}
+	return r, nil
+}
+
+// BoolToUint64 按 many.To[uint64] 的规则将 bool 转换为 uint64
+func BoolToUint64(v bool) uint64 {
+	return boolAsUint64(v)
+}
+
+// BoolToUint64E 按 many.ToE[uint64] 的规则将 bool 转换为 uint64, 结果为零值或越界时返回错误
+func BoolToUint64E(v bool) (uint64, error) {
+	r := BoolToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to uint64")
+	}
+	return r, nil
+}
+
+// BoolToFloat32 按 many.To[float32] 的规则将 bool 转换为 float32
+func BoolToFloat32(v bool) float32 {
+	return float32(boolAsFloat64(v))
+}
+
+// BoolToFloat32E 按 many.ToE[float32] 的规则将 bool 转换为 float32, 结果为零值或越界时返回错误
+func BoolToFloat32E(v bool) (float32, error) {
+	r := BoolToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to float32")
+	}
+	return r, nil
+}
+
+// BoolToFloat64 按 many.To[float64] 的规则将 bool 转换为 float64
+func BoolToFloat64(v bool) float64 {
+	return boolAsFloat64(v)
+}
+
+// BoolToFloat64E 按 many.ToE[float64] 的规则将 bool 转换为 float64, 结果为零值或越界时返回错误
+func BoolToFloat64E(v bool) (float64, error) {
+	r := BoolToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert bool to float64")
+	}
+	return r, nil
+}
+
+// StringToBool 按 many.To[bool] 的规则将 string 转换为 bool
+func StringToBool(v string) bool {
+	return stringAsBool(v)
+}
+
+// StringToBoolE 按 many.ToE[bool] 的规则将 string 转换为 bool, 结果为零值或越界时返回错误
+func StringToBoolE(v string) (bool, error) {
+	r := StringToBool(v)
+	if !r {
+		return false, errors.New("cannot convert string to bool")
+	}
+	return r, nil
+}
+
+// StringToString 按 many.To[string] 的规则将 string 转换为 string
+func StringToString(v string) string {
+	return stringAsString(v)
+}
+
+// StringToStringE 按 many.ToE[string] 的规则将 string 转换为 string, 结果为零值或越界时返回错误
+func StringToStringE(v string) (string, error) {
+	r := StringToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert string to string")
+	}
+	return r, nil
+}
+
+// StringToInt 按 many.To[int] 的规则将 string 转换为 int
+func StringToInt(v string) int {
+	return int(stringAsInt64(v))
+}
+
+// StringToIntE 按 many.ToE[int] 的规则将 string 转换为 int, 结果为零值或越界时返回错误
+func StringToIntE(v string) (int, error) {
+	r := StringToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to int")
+	}
+	if overflowsString(v, math.MinInt, math.MaxInt) {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// StringToInt8 按 many.To[int8] 的规则将 string 转换为 int8
+func StringToInt8(v string) int8 {
+	return int8(stringAsInt64(v))
+}
+
+// StringToInt8E 按 many.ToE[int8] 的规则将 string 转换为 int8, 结果为零值或越界时返回错误
+func StringToInt8E(v string) (int8, error) {
+	r := StringToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to int8")
+	}
+	if overflowsString(v, math.MinInt8, math.MaxInt8) {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// StringToInt16 按 many.To[int16] 的规则将 string 转换为 int16
+func StringToInt16(v string) int16 {
+	return int16(stringAsInt64(v))
+}
+
+// StringToInt16E 按 many.ToE[int16] 的规则将 string 转换为 int16, 结果为零值或越界时返回错误
+func StringToInt16E(v string) (int16, error) {
+	r := StringToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to int16")
+	}
+	if overflowsString(v, math.MinInt16, math.MaxInt16) {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// StringToInt32 按 many.To[int32] 的规则将 string 转换为 int32
+func StringToInt32(v string) int32 {
+	return int32(stringAsInt64(v))
+}
+
+// StringToInt32E 按 many.ToE[int32] 的规则将 string 转换为 int32, 结果为零值或越界时返回错误
+func StringToInt32E(v string) (int32, error) {
+	r := StringToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to int32")
+	}
+	if overflowsString(v, math.MinInt32, math.MaxInt32) {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// StringToInt64 按 many.To[int64] 的规则将 string 转换为 int64
+func StringToInt64(v string) int64 {
+	return stringAsInt64(v)
+}
+
+// StringToInt64E 按 many.ToE[int64] 的规则将 string 转换为 int64, 结果为零值或越界时返回错误
+func StringToInt64E(v string) (int64, error) {
+	r := StringToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to int64")
+	}
+	if overflowsString(v, math.MinInt64, math.MaxInt64) {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// StringToUint 按 many.To[uint] 的规则将 string 转换为 uint
+func StringToUint(v string) uint {
+	return uint(stringAsUint64(v))
+}
+
+// StringToUintE 按 many.ToE[uint] 的规则将 string 转换为 uint, 结果为零值或越界时返回错误
+func StringToUintE(v string) (uint, error) {
+	r := StringToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to uint")
+	}
+	if overflowsString(v, 0, math.MaxUint) {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// StringToUint8 按 many.To[uint8] 的规则将 string 转换为 uint8
+func StringToUint8(v string) uint8 {
+	return uint8(stringAsUint64(v))
+}
+
+// StringToUint8E 按 many.ToE[uint8] 的规则将 string 转换为 uint8, 结果为零值或越界时返回错误
+func StringToUint8E(v string) (uint8, error) {
+	r := StringToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to uint8")
+	}
+	if overflowsString(v, 0, math.MaxUint8) {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// StringToUint16 按 many.To[uint16] 的规则将 string 转换为 uint16
+func StringToUint16(v string) uint16 {
+	return uint16(stringAsUint64(v))
+}
+
+// StringToUint16E 按 many.ToE[uint16] 的规则将 string 转换为 uint16, 结果为零值或越界时返回错误
+func StringToUint16E(v string) (uint16, error) {
+	r := StringToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to uint16")
+	}
+	if overflowsString(v, 0, math.MaxUint16) {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// StringToUint32 按 many.To[uint32] 的规则将 string 转换为 uint32
+func StringToUint32(v string) uint32 {
+	return uint32(stringAsUint64(v))
+}
+
+// StringToUint32E 按 many.ToE[uint32] 的规则将 string 转换为 uint32, 结果为零值或越界时返回错误
+func StringToUint32E(v string) (uint32, error) {
+	r := StringToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to uint32")
+	}
+	if overflowsString(v, 0, math.MaxUint32) {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// StringToUint64 按 many.To[uint64] 的规则将 string 转换为 uint64
+func StringToUint64(v string) uint64 {
+	return stringAsUint64(v)
+}
+
+// StringToUint64E 按 many.ToE[uint64] 的规则将 string 转换为 uint64, 结果为零值或越界时返回错误
+func StringToUint64E(v string) (uint64, error) {
+	r := StringToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to uint64")
+	}
+	if overflowsString(v, 0, math.MaxUint64) {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// StringToFloat32 按 many.To[float32] 的规则将 string 转换为 float32
+func StringToFloat32(v string) float32 {
+	return float32(stringAsFloat64(v))
+}
+
+// StringToFloat32E 按 many.ToE[float32] 的规则将 string 转换为 float32, 结果为零值或越界时返回错误
+func StringToFloat32E(v string) (float32, error) {
+	r := StringToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to float32")
+	}
+	if overflowsFloat32(stringAsFloat64(v)) {
+		return 0, fmt.Errorf("value %v overflows float32", v)
+	}
+	return r, nil
+}
+
+// StringToFloat64 按 many.To[float64] 的规则将 string 转换为 float64
+func StringToFloat64(v string) float64 {
+	return stringAsFloat64(v)
+}
+
+// StringToFloat64E 按 many.ToE[float64] 的规则将 string 转换为 float64, 结果为零值或越界时返回错误
+func StringToFloat64E(v string) (float64, error) {
+	r := StringToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert string to float64")
+	}
+	return r, nil
+}
+
+// IntToBool 按 many.To[bool] 的规则将 int 转换为 bool
+func IntToBool(v int) bool {
+	return intAsBool(v)
+}
+
+// IntToBoolE 按 many.ToE[bool] 的规则将 int 转换为 bool, 结果为零值或越界时返回错误
+func IntToBoolE(v int) (bool, error) {
+	r := IntToBool(v)
+	if !r {
+		return false, errors.New("cannot convert int to bool")
+	}
+	return r, nil
+}
+
+// IntToString 按 many.To[string] 的规则将 int 转换为 string
+func IntToString(v int) string {
+	return intAsString(v)
+}
+
+// IntToStringE 按 many.ToE[string] 的规则将 int 转换为 string, 结果为零值或越界时返回错误
+func IntToStringE(v int) (string, error) {
+	r := IntToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert int to string")
+	}
+	return r, nil
+}
+
+// IntToInt 按 many.To[int] 的规则将 int 转换为 int
+func IntToInt(v int) int {
+	return int(intAsInt64(v))
+}
+
+// IntToIntE 按 many.ToE[int] 的规则将 int 转换为 int, 结果为零值或越界时返回错误
+func IntToIntE(v int) (int, error) {
+	r := IntToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to int")
+	}
+	if overflowsInt(int64(v), math.MinInt, math.MaxInt) {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// IntToInt8 按 many.To[int8] 的规则将 int 转换为 int8
+func IntToInt8(v int) int8 {
+	return int8(intAsInt64(v))
+}
+
+// IntToInt8E 按 many.ToE[int8] 的规则将 int 转换为 int8, 结果为零值或越界时返回错误
+func IntToInt8E(v int) (int8, error) {
+	r := IntToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to int8")
+	}
+	if overflowsInt(int64(v), math.MinInt8, math.MaxInt8) {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// IntToInt16 按 many.To[int16] 的规则将 int 转换为 int16
+func IntToInt16(v int) int16 {
+	return int16(intAsInt64(v))
+}
+
+// IntToInt16E 按 many.ToE[int16] 的规则将 int 转换为 int16, 结果为零值或越界时返回错误
+func IntToInt16E(v int) (int16, error) {
+	r := IntToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to int16")
+	}
+	if overflowsInt(int64(v), math.MinInt16, math.MaxInt16) {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// IntToInt32 按 many.To[int32] 的规则将 int 转换为 int32
+func IntToInt32(v int) int32 {
+	return int32(intAsInt64(v))
+}
+
+// IntToInt32E 按 many.ToE[int32] 的规则将 int 转换为 int32, 结果为零值或越界时返回错误
+func IntToInt32E(v int) (int32, error) {
+	r := IntToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to int32")
+	}
+	if overflowsInt(int64(v), math.MinInt32, math.MaxInt32) {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// IntToInt64 按 many.To[int64] 的规则将 int 转换为 int64
+func IntToInt64(v int) int64 {
+	return intAsInt64(v)
+}
+
+// IntToInt64E 按 many.ToE[int64] 的规则将 int 转换为 int64, 结果为零值或越界时返回错误
+func IntToInt64E(v int) (int64, error) {
+	r := IntToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to int64")
+	}
+	if overflowsInt(int64(v), math.MinInt64, math.MaxInt64) {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// IntToUint 按 many.To[uint] 的规则将 int 转换为 uint
+func IntToUint(v int) uint {
+	return uint(intAsUint64(v))
+}
+
+// IntToUintE 按 many.ToE[uint] 的规则将 int 转换为 uint, 结果为零值或越界时返回错误
+func IntToUintE(v int) (uint, error) {
+	r := IntToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to uint")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint) {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// IntToUint8 按 many.To[uint8] 的规则将 int 转换为 uint8
+func IntToUint8(v int) uint8 {
+	return uint8(intAsUint64(v))
+}
+
+// IntToUint8E 按 many.ToE[uint8] 的规则将 int 转换为 uint8, 结果为零值或越界时返回错误
+func IntToUint8E(v int) (uint8, error) {
+	r := IntToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to uint8")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint8) {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// IntToUint16 按 many.To[uint16] 的规则将 int 转换为 uint16
+func IntToUint16(v int) uint16 {
+	return uint16(intAsUint64(v))
+}
+
+// IntToUint16E 按 many.ToE[uint16] 的规则将 int 转换为 uint16, 结果为零值或越界时返回错误
+func IntToUint16E(v int) (uint16, error) {
+	r := IntToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to uint16")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint16) {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// IntToUint32 按 many.To[uint32] 的规则将 int 转换为 uint32
+func IntToUint32(v int) uint32 {
+	return uint32(intAsUint64(v))
+}
+
+// IntToUint32E 按 many.ToE[uint32] 的规则将 int 转换为 uint32, 结果为零值或越界时返回错误
+func IntToUint32E(v int) (uint32, error) {
+	r := IntToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to uint32")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint32) {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// IntToUint64 按 many.To[uint64] 的规则将 int 转换为 uint64
+func IntToUint64(v int) uint64 {
+	return intAsUint64(v)
+}
+
+// IntToUint64E 按 many.ToE[uint64] 的规则将 int 转换为 uint64, 结果为零值或越界时返回错误
+func IntToUint64E(v int) (uint64, error) {
+	r := IntToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to uint64")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint64) {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// IntToFloat32 按 many.To[float32] 的规则将 int 转换为 float32
+func IntToFloat32(v int) float32 {
+	return float32(intAsFloat64(v))
+}
+
+// IntToFloat32E 按 many.ToE[float32] 的规则将 int 转换为 float32, 结果为零值或越界时返回错误
+func IntToFloat32E(v int) (float32, error) {
+	r := IntToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to float32")
+	}
+	return r, nil
+}
+
+// IntToFloat64 按 many.To[float64] 的规则将 int 转换为 float64
+func IntToFloat64(v int) float64 {
+	return intAsFloat64(v)
+}
+
+// IntToFloat64E 按 many.ToE[float64] 的规则将 int 转换为 float64, 结果为零值或越界时返回错误
+func IntToFloat64E(v int) (float64, error) {
+	r := IntToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int to float64")
+	}
+	return r, nil
+}
+
+// Int8ToBool 按 many.To[bool] 的规则将 int8 转换为 bool
+func Int8ToBool(v int8) bool {
+	return int8AsBool(v)
+}
+
+// Int8ToBoolE 按 many.ToE[bool] 的规则将 int8 转换为 bool, 结果为零值或越界时返回错误
+func Int8ToBoolE(v int8) (bool, error) {
+	r := Int8ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert int8 to bool")
+	}
+	return r, nil
+}
+
+// Int8ToString 按 many.To[string] 的规则将 int8 转换为 string
+func Int8ToString(v int8) string {
+	return int8AsString(v)
+}
+
+// Int8ToStringE 按 many.ToE[string] 的规则将 int8 转换为 string, 结果为零值或越界时返回错误
+func Int8ToStringE(v int8) (string, error) {
+	r := Int8ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert int8 to string")
+	}
+	return r, nil
+}
+
+// Int8ToInt 按 many.To[int] 的规则将 int8 转换为 int
+func Int8ToInt(v int8) int {
+	return int(int8AsInt64(v))
+}
+
+// Int8ToIntE 按 many.ToE[int] 的规则将 int8 转换为 int, 结果为零值或越界时返回错误
+func Int8ToIntE(v int8) (int, error) {
+	r := Int8ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to int")
+	}
+	if overflowsInt(int64(v), math.MinInt, math.MaxInt) {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Int8ToInt8 按 many.To[int8] 的规则将 int8 转换为 int8
+func Int8ToInt8(v int8) int8 {
+	return int8(int8AsInt64(v))
+}
+
+// Int8ToInt8E 按 many.ToE[int8] 的规则将 int8 转换为 int8, 结果为零值或越界时返回错误
+func Int8ToInt8E(v int8) (int8, error) {
+	r := Int8ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to int8")
+	}
+	if overflowsInt(int64(v), math.MinInt8, math.MaxInt8) {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Int8ToInt16 按 many.To[int16] 的规则将 int8 转换为 int16
+func Int8ToInt16(v int8) int16 {
+	return int16(int8AsInt64(v))
+}
+
+// Int8ToInt16E 按 many.ToE[int16] 的规则将 int8 转换为 int16, 结果为零值或越界时返回错误
+func Int8ToInt16E(v int8) (int16, error) {
+	r := Int8ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to int16")
+	}
+	if overflowsInt(int64(v), math.MinInt16, math.MaxInt16) {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Int8ToInt32 按 many.To[int32] 的规则将 int8 转换为 int32
+func Int8ToInt32(v int8) int32 {
+	return int32(int8AsInt64(v))
+}
+
+// Int8ToInt32E 按 many.ToE[int32] 的规则将 int8 转换为 int32, 结果为零值或越界时返回错误
+func Int8ToInt32E(v int8) (int32, error) {
+	r := Int8ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to int32")
+	}
+	if overflowsInt(int64(v), math.MinInt32, math.MaxInt32) {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Int8ToInt64 按 many.To[int64] 的规则将 int8 转换为 int64
+func Int8ToInt64(v int8) int64 {
+	return int8AsInt64(v)
+}
+
+// Int8ToInt64E 按 many.ToE[int64] 的规则将 int8 转换为 int64, 结果为零值或越界时返回错误
+func Int8ToInt64E(v int8) (int64, error) {
+	r := Int8ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to int64")
+	}
+	if overflowsInt(int64(v), math.MinInt64, math.MaxInt64) {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Int8ToUint 按 many.To[uint] 的规则将 int8 转换为 uint
+func Int8ToUint(v int8) uint {
+	return uint(int8AsUint64(v))
+}
+
+// Int8ToUintE 按 many.ToE[uint] 的规则将 int8 转换为 uint, 结果为零值或越界时返回错误
+func Int8ToUintE(v int8) (uint, error) {
+	r := Int8ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to uint")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint) {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Int8ToUint8 按 many.To[uint8] 的规则将 int8 转换为 uint8
+func Int8ToUint8(v int8) uint8 {
+	return uint8(int8AsUint64(v))
+}
+
+// Int8ToUint8E 按 many.ToE[uint8] 的规则将 int8 转换为 uint8, 结果为零值或越界时返回错误
+func Int8ToUint8E(v int8) (uint8, error) {
+	r := Int8ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to uint8")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint8) {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Int8ToUint16 按 many.To[uint16] 的规则将 int8 转换为 uint16
+func Int8ToUint16(v int8) uint16 {
+	return uint16(int8AsUint64(v))
+}
+
+// Int8ToUint16E 按 many.ToE[uint16] 的规则将 int8 转换为 uint16, 结果为零值或越界时返回错误
+func Int8ToUint16E(v int8) (uint16, error) {
+	r := Int8ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to uint16")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint16) {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Int8ToUint32 按 many.To[uint32] 的规则将 int8 转换为 uint32
+func Int8ToUint32(v int8) uint32 {
+	return uint32(int8AsUint64(v))
+}
+
+// Int8ToUint32E 按 many.ToE[uint32] 的规则将 int8 转换为 uint32, 结果为零值或越界时返回错误
+func Int8ToUint32E(v int8) (uint32, error) {
+	r := Int8ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to uint32")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint32) {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Int8ToUint64 按 many.To[uint64] 的规则将 int8 转换为 uint64
+func Int8ToUint64(v int8) uint64 {
+	return int8AsUint64(v)
+}
+
+// Int8ToUint64E 按 many.ToE[uint64] 的规则将 int8 转换为 uint64, 结果为零值或越界时返回错误
+func Int8ToUint64E(v int8) (uint64, error) {
+	r := Int8ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to uint64")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint64) {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Int8ToFloat32 按 many.To[float32] 的规则将 int8 转换为 float32
+func Int8ToFloat32(v int8) float32 {
+	return float32(int8AsFloat64(v))
+}
+
+// Int8ToFloat32E 按 many.ToE[float32] 的规则将 int8 转换为 float32, 结果为零值或越界时返回错误
+func Int8ToFloat32E(v int8) (float32, error) {
+	r := Int8ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to float32")
+	}
+	return r, nil
+}
+
+// Int8ToFloat64 按 many.To[float64] 的规则将 int8 转换为 float64
+func Int8ToFloat64(v int8) float64 {
+	return int8AsFloat64(v)
+}
+
+// Int8ToFloat64E 按 many.ToE[float64] 的规则将 int8 转换为 float64, 结果为零值或越界时返回错误
+func Int8ToFloat64E(v int8) (float64, error) {
+	r := Int8ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int8 to float64")
+	}
+	return r, nil
+}
+
+// Int16ToBool 按 many.To[bool] 的规则将 int16 转换为 bool
+func Int16ToBool(v int16) bool {
+	return int16AsBool(v)
+}
+
+// Int16ToBoolE 按 many.ToE[bool] 的规则将 int16 转换为 bool, 结果为零值或越界时返回错误
+func Int16ToBoolE(v int16) (bool, error) {
+	r := Int16ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert int16 to bool")
+	}
+	return r, nil
+}
+
+// Int16ToString 按 many.To[string] 的规则将 int16 转换为 string
+func Int16ToString(v int16) string {
+	return int16AsString(v)
+}
+
+// Int16ToStringE 按 many.ToE[string] 的规则将 int16 转换为 string, 结果为零值或越界时返回错误
+func Int16ToStringE(v int16) (string, error) {
+	r := Int16ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert int16 to string")
+	}
+	return r, nil
+}
+
+// Int16ToInt 按 many.To[int] 的规则将 int16 转换为 int
+func Int16ToInt(v int16) int {
+	return int(int16AsInt64(v))
+}
+
+// Int16ToIntE 按 many.ToE[int] 的规则将 int16 转换为 int, 结果为零值或越界时返回错误
+func Int16ToIntE(v int16) (int, error) {
+	r := Int16ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to int")
+	}
+	if overflowsInt(int64(v), math.MinInt, math.MaxInt) {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Int16ToInt8 按 many.To[int8] 的规则将 int16 转换为 int8
+func Int16ToInt8(v int16) int8 {
+	return int8(int16AsInt64(v))
+}
+
+// Int16ToInt8E 按 many.ToE[int8] 的规则将 int16 转换为 int8, 结果为零值或越界时返回错误
+func Int16ToInt8E(v int16) (int8, error) {
+	r := Int16ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to int8")
+	}
+	if overflowsInt(int64(v), math.MinInt8, math.MaxInt8) {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Int16ToInt16 按 many.To[int16] 的规则将 int16 转换为 int16
+func Int16ToInt16(v int16) int16 {
+	return int16(int16AsInt64(v))
+}
+
+// Int16ToInt16E 按 many.ToE[int16] 的规则将 int16 转换为 int16, 结果为零值或越界时返回错误
+func Int16ToInt16E(v int16) (int16, error) {
+	r := Int16ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to int16")
+	}
+	if overflowsInt(int64(v), math.MinInt16, math.MaxInt16) {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Int16ToInt32 按 many.To[int32] 的规则将 int16 转换为 int32
+func Int16ToInt32(v int16) int32 {
+	return int32(int16AsInt64(v))
+}
+
+// Int16ToInt32E 按 many.ToE[int32] 的规则将 int16 转换为 int32, 结果为零值或越界时返回错误
+func Int16ToInt32E(v int16) (int32, error) {
+	r := Int16ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to int32")
+	}
+	if overflowsInt(int64(v), math.MinInt32, math.MaxInt32) {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Int16ToInt64 按 many.To[int64] 的规则将 int16 转换为 int64
+func Int16ToInt64(v int16) int64 {
+	return int16AsInt64(v)
+}
+
+// Int16ToInt64E 按 many.ToE[int64] 的规则将 int16 转换为 int64, 结果为零值或越界时返回错误
+func Int16ToInt64E(v int16) (int64, error) {
+	r := Int16ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to int64")
+	}
+	if overflowsInt(int64(v), math.MinInt64, math.MaxInt64) {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Int16ToUint 按 many.To[uint] 的规则将 int16 转换为 uint
+func Int16ToUint(v int16) uint {
+	return uint(int16AsUint64(v))
+}
+
+// Int16ToUintE 按 many.ToE[uint] 的规则将 int16 转换为 uint, 结果为零值或越界时返回错误
+func Int16ToUintE(v int16) (uint, error) {
+	r := Int16ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to uint")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint) {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Int16ToUint8 按 many.To[uint8] 的规则将 int16 转换为 uint8
+func Int16ToUint8(v int16) uint8 {
+	return uint8(int16AsUint64(v))
+}
+
+// Int16ToUint8E 按 many.ToE[uint8] 的规则将 int16 转换为 uint8, 结果为零值或越界时返回错误
+func Int16ToUint8E(v int16) (uint8, error) {
+	r := Int16ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to uint8")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint8) {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Int16ToUint16 按 many.To[uint16] 的规则将 int16 转换为 uint16
+func Int16ToUint16(v int16) uint16 {
+	return uint16(int16AsUint64(v))
+}
+
+// Int16ToUint16E 按 many.ToE[uint16] 的规则将 int16 转换为 uint16, 结果为零值或越界时返回错误
+func Int16ToUint16E(v int16) (uint16, error) {
+	r := Int16ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to uint16")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint16) {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Int16ToUint32 按 many.To[uint32] 的规则将 int16 转换为 uint32
+func Int16ToUint32(v int16) uint32 {
+	return uint32(int16AsUint64(v))
+}
+
+// Int16ToUint32E 按 many.ToE[uint32] 的规则将 int16 转换为 uint32, 结果为零值或越界时返回错误
+func Int16ToUint32E(v int16) (uint32, error) {
+	r := Int16ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to uint32")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint32) {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Int16ToUint64 按 many.To[uint64] 的规则将 int16 转换为 uint64
+func Int16ToUint64(v int16) uint64 {
+	return int16AsUint64(v)
+}
+
+// Int16ToUint64E 按 many.ToE[uint64] 的规则将 int16 转换为 uint64, 结果为零值或越界时返回错误
+func Int16ToUint64E(v int16) (uint64, error) {
+	r := Int16ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to uint64")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint64) {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Int16ToFloat32 按 many.To[float32] 的规则将 int16 转换为 float32
+func Int16ToFloat32(v int16) float32 {
+	return float32(int16AsFloat64(v))
+}
+
+// Int16ToFloat32E 按 many.ToE[float32] 的规则将 int16 转换为 float32, 结果为零值或越界时返回错误
+func Int16ToFloat32E(v int16) (float32, error) {
+	r := Int16ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to float32")
+	}
+	return r, nil
+}
+
+// Int16ToFloat64 按 many.To[float64] 的规则将 int16 转换为 float64
+func Int16ToFloat64(v int16) float64 {
+	return int16AsFloat64(v)
+}
+
+// Int16ToFloat64E 按 many.ToE[float64] 的规则将 int16 转换为 float64, 结果为零值或越界时返回错误
+func Int16ToFloat64E(v int16) (float64, error) {
+	r := Int16ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int16 to float64")
+	}
+	return r, nil
+}
+
+// Int32ToBool 按 many.To[bool] 的规则将 int32 转换为 bool
+func Int32ToBool(v int32) bool {
+	return int32AsBool(v)
+}
+
+// Int32ToBoolE 按 many.ToE[bool] 的规则将 int32 转换为 bool, 结果为零值或越界时返回错误
+func Int32ToBoolE(v int32) (bool, error) {
+	r := Int32ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert int32 to bool")
+	}
+	return r, nil
+}
+
+// Int32ToString 按 many.To[string] 的规则将 int32 转换为 string
+func Int32ToString(v int32) string {
+	return int32AsString(v)
+}
+
+// Int32ToStringE 按 many.ToE[string] 的规则将 int32 转换为 string, 结果为零值或越界时返回错误
+func Int32ToStringE(v int32) (string, error) {
+	r := Int32ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert int32 to string")
+	}
+	return r, nil
+}
+
+// Int32ToInt 按 many.To[int] 的规则将 int32 转换为 int
+func Int32ToInt(v int32) int {
+	return int(int32AsInt64(v))
+}
+
+// Int32ToIntE 按 many.ToE[int] 的规则将 int32 转换为 int, 结果为零值或越界时返回错误
+func Int32ToIntE(v int32) (int, error) {
+	r := Int32ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to int")
+	}
+	if overflowsInt(int64(v), math.MinInt, math.MaxInt) {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Int32ToInt8 按 many.To[int8] 的规则将 int32 转换为 int8
+func Int32ToInt8(v int32) int8 {
+	return int8(int32AsInt64(v))
+}
+
+// Int32ToInt8E 按 many.ToE[int8] 的规则将 int32 转换为 int8, 结果为零值或越界时返回错误
+func Int32ToInt8E(v int32) (int8, error) {
+	r := Int32ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to int8")
+	}
+	if overflowsInt(int64(v), math.MinInt8, math.MaxInt8) {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Int32ToInt16 按 many.To[int16] 的规则将 int32 转换为 int16
+func Int32ToInt16(v int32) int16 {
+	return int16(int32AsInt64(v))
+}
+
+// Int32ToInt16E 按 many.ToE[int16] 的规则将 int32 转换为 int16, 结果为零值或越界时返回错误
+func Int32ToInt16E(v int32) (int16, error) {
+	r := Int32ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to int16")
+	}
+	if overflowsInt(int64(v), math.MinInt16, math.MaxInt16) {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Int32ToInt32 按 many.To[int32] 的规则将 int32 转换为 int32
+func Int32ToInt32(v int32) int32 {
+	return int32(int32AsInt64(v))
+}
+
+// Int32ToInt32E 按 many.ToE[int32] 的规则将 int32 转换为 int32, 结果为零值或越界时返回错误
+func Int32ToInt32E(v int32) (int32, error) {
+	r := Int32ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to int32")
+	}
+	if overflowsInt(int64(v), math.MinInt32, math.MaxInt32) {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Int32ToInt64 按 many.To[int64] 的规则将 int32 转换为 int64
+func Int32ToInt64(v int32) int64 {
+	return int32AsInt64(v)
+}
+
+// Int32ToInt64E 按 many.ToE[int64] 的规则将 int32 转换为 int64, 结果为零值或越界时返回错误
+func Int32ToInt64E(v int32) (int64, error) {
+	r := Int32ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to int64")
+	}
+	if overflowsInt(int64(v), math.MinInt64, math.MaxInt64) {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Int32ToUint 按 many.To[uint] 的规则将 int32 转换为 uint
+func Int32ToUint(v int32) uint {
+	return uint(int32AsUint64(v))
+}
+
+// Int32ToUintE 按 many.ToE[uint] 的规则将 int32 转换为 uint, 结果为零值或越界时返回错误
+func Int32ToUintE(v int32) (uint, error) {
+	r := Int32ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to uint")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint) {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Int32ToUint8 按 many.To[uint8] 的规则将 int32 转换为 uint8
+func Int32ToUint8(v int32) uint8 {
+	return uint8(int32AsUint64(v))
+}
+
+// Int32ToUint8E 按 many.ToE[uint8] 的规则将 int32 转换为 uint8, 结果为零值或越界时返回错误
+func Int32ToUint8E(v int32) (uint8, error) {
+	r := Int32ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to uint8")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint8) {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Int32ToUint16 按 many.To[uint16] 的规则将 int32 转换为 uint16
+func Int32ToUint16(v int32) uint16 {
+	return uint16(int32AsUint64(v))
+}
+
+// Int32ToUint16E 按 many.ToE[uint16] 的规则将 int32 转换为 uint16, 结果为零值或越界时返回错误
+func Int32ToUint16E(v int32) (uint16, error) {
+	r := Int32ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to uint16")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint16) {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Int32ToUint32 按 many.To[uint32] 的规则将 int32 转换为 uint32
+func Int32ToUint32(v int32) uint32 {
+	return uint32(int32AsUint64(v))
+}
+
+// Int32ToUint32E 按 many.ToE[uint32] 的规则将 int32 转换为 uint32, 结果为零值或越界时返回错误
+func Int32ToUint32E(v int32) (uint32, error) {
+	r := Int32ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to uint32")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint32) {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Int32ToUint64 按 many.To[uint64] 的规则将 int32 转换为 uint64
+func Int32ToUint64(v int32) uint64 {
+	return int32AsUint64(v)
+}
+
+// Int32ToUint64E 按 many.ToE[uint64] 的规则将 int32 转换为 uint64, 结果为零值或越界时返回错误
+func Int32ToUint64E(v int32) (uint64, error) {
+	r := Int32ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to uint64")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint64) {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Int32ToFloat32 按 many.To[float32] 的规则将 int32 转换为 float32
+func Int32ToFloat32(v int32) float32 {
+	return float32(int32AsFloat64(v))
+}
+
+// Int32ToFloat32E 按 many.ToE[float32] 的规则将 int32 转换为 float32, 结果为零值或越界时返回错误
+func Int32ToFloat32E(v int32) (float32, error) {
+	r := Int32ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to float32")
+	}
+	return r, nil
+}
+
+// Int32ToFloat64 按 many.To[float64] 的规则将 int32 转换为 float64
+func Int32ToFloat64(v int32) float64 {
+	return int32AsFloat64(v)
+}
+
+// Int32ToFloat64E 按 many.ToE[float64] 的规则将 int32 转换为 float64, 结果为零值或越界时返回错误
+func Int32ToFloat64E(v int32) (float64, error) {
+	r := Int32ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int32 to float64")
+	}
+	return r, nil
+}
+
+// Int64ToBool 按 many.To[bool] 的规则将 int64 转换为 bool
+func Int64ToBool(v int64) bool {
+	return int64AsBool(v)
+}
+
+// Int64ToBoolE 按 many.ToE[bool] 的规则将 int64 转换为 bool, 结果为零值或越界时返回错误
+func Int64ToBoolE(v int64) (bool, error) {
+	r := Int64ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert int64 to bool")
+	}
+	return r, nil
+}
+
+// Int64ToString 按 many.To[string] 的规则将 int64 转换为 string
+func Int64ToString(v int64) string {
+	return int64AsString(v)
+}
+
+// Int64ToStringE 按 many.ToE[string] 的规则将 int64 转换为 string, 结果为零值或越界时返回错误
+func Int64ToStringE(v int64) (string, error) {
+	r := Int64ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert int64 to string")
+	}
+	return r, nil
+}
+
+// Int64ToInt 按 many.To[int] 的规则将 int64 转换为 int
+func Int64ToInt(v int64) int {
+	return int(int64AsInt64(v))
+}
+
+// Int64ToIntE 按 many.ToE[int] 的规则将 int64 转换为 int, 结果为零值或越界时返回错误
+func Int64ToIntE(v int64) (int, error) {
+	r := Int64ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to int")
+	}
+	if overflowsInt(int64(v), math.MinInt, math.MaxInt) {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Int64ToInt8 按 many.To[int8] 的规则将 int64 转换为 int8
+func Int64ToInt8(v int64) int8 {
+	return int8(int64AsInt64(v))
+}
+
+// Int64ToInt8E 按 many.ToE[int8] 的规则将 int64 转换为 int8, 结果为零值或越界时返回错误
+func Int64ToInt8E(v int64) (int8, error) {
+	r := Int64ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to int8")
+	}
+	if overflowsInt(int64(v), math.MinInt8, math.MaxInt8) {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Int64ToInt16 按 many.To[int16] 的规则将 int64 转换为 int16
+func Int64ToInt16(v int64) int16 {
+	return int16(int64AsInt64(v))
+}
+
+// Int64ToInt16E 按 many.ToE[int16] 的规则将 int64 转换为 int16, 结果为零值或越界时返回错误
+func Int64ToInt16E(v int64) (int16, error) {
+	r := Int64ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to int16")
+	}
+	if overflowsInt(int64(v), math.MinInt16, math.MaxInt16) {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Int64ToInt32 按 many.To[int32] 的规则将 int64 转换为 int32
+func Int64ToInt32(v int64) int32 {
+	return int32(int64AsInt64(v))
+}
+
+// Int64ToInt32E 按 many.ToE[int32] 的规则将 int64 转换为 int32, 结果为零值或越界时返回错误
+func Int64ToInt32E(v int64) (int32, error) {
+	r := Int64ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to int32")
+	}
+	if overflowsInt(int64(v), math.MinInt32, math.MaxInt32) {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Int64ToInt64 按 many.To[int64] 的规则将 int64 转换为 int64
+func Int64ToInt64(v int64) int64 {
+	return int64AsInt64(v)
+}
+
+// Int64ToInt64E 按 many.ToE[int64] 的规则将 int64 转换为 int64, 结果为零值或越界时返回错误
+func Int64ToInt64E(v int64) (int64, error) {
+	r := Int64ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to int64")
+	}
+	if overflowsInt(int64(v), math.MinInt64, math.MaxInt64) {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Int64ToUint 按 many.To[uint] 的规则将 int64 转换为 uint
+func Int64ToUint(v int64) uint {
+	return uint(int64AsUint64(v))
+}
+
+// Int64ToUintE 按 many.ToE[uint] 的规则将 int64 转换为 uint, 结果为零值或越界时返回错误
+func Int64ToUintE(v int64) (uint, error) {
+	r := Int64ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to uint")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint) {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Int64ToUint8 按 many.To[uint8] 的规则将 int64 转换为 uint8
+func Int64ToUint8(v int64) uint8 {
+	return uint8(int64AsUint64(v))
+}
+
+// Int64ToUint8E 按 many.ToE[uint8] 的规则将 int64 转换为 uint8, 结果为零值或越界时返回错误
+func Int64ToUint8E(v int64) (uint8, error) {
+	r := Int64ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to uint8")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint8) {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Int64ToUint16 按 many.To[uint16] 的规则将 int64 转换为 uint16
+func Int64ToUint16(v int64) uint16 {
+	return uint16(int64AsUint64(v))
+}
+
+// Int64ToUint16E 按 many.ToE[uint16] 的规则将 int64 转换为 uint16, 结果为零值或越界时返回错误
+func Int64ToUint16E(v int64) (uint16, error) {
+	r := Int64ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to uint16")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint16) {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Int64ToUint32 按 many.To[uint32] 的规则将 int64 转换为 uint32
+func Int64ToUint32(v int64) uint32 {
+	return uint32(int64AsUint64(v))
+}
+
+// Int64ToUint32E 按 many.ToE[uint32] 的规则将 int64 转换为 uint32, 结果为零值或越界时返回错误
+func Int64ToUint32E(v int64) (uint32, error) {
+	r := Int64ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to uint32")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint32) {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Int64ToUint64 按 many.To[uint64] 的规则将 int64 转换为 uint64
+func Int64ToUint64(v int64) uint64 {
+	return int64AsUint64(v)
+}
+
+// Int64ToUint64E 按 many.ToE[uint64] 的规则将 int64 转换为 uint64, 结果为零值或越界时返回错误
+func Int64ToUint64E(v int64) (uint64, error) {
+	r := Int64ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to uint64")
+	}
+	if overflowsInt(int64(v), 0, math.MaxUint64) {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Int64ToFloat32 按 many.To[float32] 的规则将 int64 转换为 float32
+func Int64ToFloat32(v int64) float32 {
+	return float32(int64AsFloat64(v))
+}
+
+// Int64ToFloat32E 按 many.ToE[float32] 的规则将 int64 转换为 float32, 结果为零值或越界时返回错误
+func Int64ToFloat32E(v int64) (float32, error) {
+	r := Int64ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to float32")
+	}
+	return r, nil
+}
+
+// Int64ToFloat64 按 many.To[float64] 的规则将 int64 转换为 float64
+func Int64ToFloat64(v int64) float64 {
+	return int64AsFloat64(v)
+}
+
+// Int64ToFloat64E 按 many.ToE[float64] 的规则将 int64 转换为 float64, 结果为零值或越界时返回错误
+func Int64ToFloat64E(v int64) (float64, error) {
+	r := Int64ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert int64 to float64")
+	}
+	return r, nil
+}
+
+// UintToBool 按 many.To[bool] 的规则将 uint 转换为 bool
+func UintToBool(v uint) bool {
+	return uintAsBool(v)
+}
+
+// UintToBoolE 按 many.ToE[bool] 的规则将 uint 转换为 bool, 结果为零值或越界时返回错误
+func UintToBoolE(v uint) (bool, error) {
+	r := UintToBool(v)
+	if !r {
+		return false, errors.New("cannot convert uint to bool")
+	}
+	return r, nil
+}
+
+// UintToString 按 many.To[string] 的规则将 uint 转换为 string
+func UintToString(v uint) string {
+	return uintAsString(v)
+}
+
+// UintToStringE 按 many.ToE[string] 的规则将 uint 转换为 string, 结果为零值或越界时返回错误
+func UintToStringE(v uint) (string, error) {
+	r := UintToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert uint to string")
+	}
+	return r, nil
+}
+
+// UintToInt 按 many.To[int] 的规则将 uint 转换为 int
+func UintToInt(v uint) int {
+	return int(uintAsInt64(v))
+}
+
+// UintToIntE 按 many.ToE[int] 的规则将 uint 转换为 int, 结果为零值或越界时返回错误
+func UintToIntE(v uint) (int, error) {
+	r := UintToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to int")
+	}
+	if uint64(v) > math.MaxInt {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// UintToInt8 按 many.To[int8] 的规则将 uint 转换为 int8
+func UintToInt8(v uint) int8 {
+	return int8(uintAsInt64(v))
+}
+
+// UintToInt8E 按 many.ToE[int8] 的规则将 uint 转换为 int8, 结果为零值或越界时返回错误
+func UintToInt8E(v uint) (int8, error) {
+	r := UintToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to int8")
+	}
+	if uint64(v) > math.MaxInt8 {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// UintToInt16 按 many.To[int16] 的规则将 uint 转换为 int16
+func UintToInt16(v uint) int16 {
+	return int16(uintAsInt64(v))
+}
+
+// UintToInt16E 按 many.ToE[int16] 的规则将 uint 转换为 int16, 结果为零值或越界时返回错误
+func UintToInt16E(v uint) (int16, error) {
+	r := UintToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to int16")
+	}
+	if uint64(v) > math.MaxInt16 {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// UintToInt32 按 many.To[int32] 的规则将 uint 转换为 int32
+func UintToInt32(v uint) int32 {
+	return int32(uintAsInt64(v))
+}
+
+// UintToInt32E 按 many.ToE[int32] 的规则将 uint 转换为 int32, 结果为零值或越界时返回错误
+func UintToInt32E(v uint) (int32, error) {
+	r := UintToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to int32")
+	}
+	if uint64(v) > math.MaxInt32 {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// UintToInt64 按 many.To[int64] 的规则将 uint 转换为 int64
+func UintToInt64(v uint) int64 {
+	return uintAsInt64(v)
+}
+
+// UintToInt64E 按 many.ToE[int64] 的规则将 uint 转换为 int64, 结果为零值或越界时返回错误
+func UintToInt64E(v uint) (int64, error) {
+	r := UintToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to int64")
+	}
+	if uint64(v) > math.MaxInt64 {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// UintToUint 按 many.To[uint] 的规则将 uint 转换为 uint
+func UintToUint(v uint) uint {
+	return uint(uintAsUint64(v))
+}
+
+// UintToUintE 按 many.ToE[uint] 的规则将 uint 转换为 uint, 结果为零值或越界时返回错误
+func UintToUintE(v uint) (uint, error) {
+	r := UintToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to uint")
+	}
+	if uint64(v) > math.MaxUint {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// UintToUint8 按 many.To[uint8] 的规则将 uint 转换为 uint8
+func UintToUint8(v uint) uint8 {
+	return uint8(uintAsUint64(v))
+}
+
+// UintToUint8E 按 many.ToE[uint8] 的规则将 uint 转换为 uint8, 结果为零值或越界时返回错误
+func UintToUint8E(v uint) (uint8, error) {
+	r := UintToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to uint8")
+	}
+	if uint64(v) > math.MaxUint8 {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// UintToUint16 按 many.To[uint16] 的规则将 uint 转换为 uint16
+func UintToUint16(v uint) uint16 {
+	return uint16(uintAsUint64(v))
+}
+
+// UintToUint16E 按 many.ToE[uint16] 的规则将 uint 转换为 uint16, 结果为零值或越界时返回错误
+func UintToUint16E(v uint) (uint16, error) {
+	r := UintToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to uint16")
+	}
+	if uint64(v) > math.MaxUint16 {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// UintToUint32 按 many.To[uint32] 的规则将 uint 转换为 uint32
+func UintToUint32(v uint) uint32 {
+	return uint32(uintAsUint64(v))
+}
+
+// UintToUint32E 按 many.ToE[uint32] 的规则将 uint 转换为 uint32, 结果为零值或越界时返回错误
+func UintToUint32E(v uint) (uint32, error) {
+	r := UintToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to uint32")
+	}
+	if uint64(v) > math.MaxUint32 {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// UintToUint64 按 many.To[uint64] 的规则将 uint 转换为 uint64
+func UintToUint64(v uint) uint64 {
+	return uintAsUint64(v)
+}
+
+// UintToUint64E 按 many.ToE[uint64] 的规则将 uint 转换为 uint64, 结果为零值或越界时返回错误
+func UintToUint64E(v uint) (uint64, error) {
+	r := UintToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to uint64")
+	}
+	if uint64(v) > math.MaxUint64 {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// UintToFloat32 按 many.To[float32] 的规则将 uint 转换为 float32
+func UintToFloat32(v uint) float32 {
+	return float32(uintAsFloat64(v))
+}
+
+// UintToFloat32E 按 many.ToE[float32] 的规则将 uint 转换为 float32, 结果为零值或越界时返回错误
+func UintToFloat32E(v uint) (float32, error) {
+	r := UintToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to float32")
+	}
+	return r, nil
+}
+
+// UintToFloat64 按 many.To[float64] 的规则将 uint 转换为 float64
+func UintToFloat64(v uint) float64 {
+	return uintAsFloat64(v)
+}
+
+// UintToFloat64E 按 many.ToE[float64] 的规则将 uint 转换为 float64, 结果为零值或越界时返回错误
+func UintToFloat64E(v uint) (float64, error) {
+	r := UintToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint to float64")
+	}
+	return r, nil
+}
+
+// Uint8ToBool 按 many.To[bool] 的规则将 uint8 转换为 bool
+func Uint8ToBool(v uint8) bool {
+	return uint8AsBool(v)
+}
+
+// Uint8ToBoolE 按 many.ToE[bool] 的规则将 uint8 转换为 bool, 结果为零值或越界时返回错误
+func Uint8ToBoolE(v uint8) (bool, error) {
+	r := Uint8ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert uint8 to bool")
+	}
+	return r, nil
+}
+
+// Uint8ToString 按 many.To[string] 的规则将 uint8 转换为 string
+func Uint8ToString(v uint8) string {
+	return uint8AsString(v)
+}
+
+// Uint8ToStringE 按 many.ToE[string] 的规则将 uint8 转换为 string, 结果为零值或越界时返回错误
+func Uint8ToStringE(v uint8) (string, error) {
+	r := Uint8ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert uint8 to string")
+	}
+	return r, nil
+}
+
+// Uint8ToInt 按 many.To[int] 的规则将 uint8 转换为 int
+func Uint8ToInt(v uint8) int {
+	return int(uint8AsInt64(v))
+}
+
+// Uint8ToIntE 按 many.ToE[int] 的规则将 uint8 转换为 int, 结果为零值或越界时返回错误
+func Uint8ToIntE(v uint8) (int, error) {
+	r := Uint8ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to int")
+	}
+	if uint64(v) > math.MaxInt {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Uint8ToInt8 按 many.To[int8] 的规则将 uint8 转换为 int8
+func Uint8ToInt8(v uint8) int8 {
+	return int8(uint8AsInt64(v))
+}
+
+// Uint8ToInt8E 按 many.ToE[int8] 的规则将 uint8 转换为 int8, 结果为零值或越界时返回错误
+func Uint8ToInt8E(v uint8) (int8, error) {
+	r := Uint8ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to int8")
+	}
+	if uint64(v) > math.MaxInt8 {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Uint8ToInt16 按 many.To[int16] 的规则将 uint8 转换为 int16
+func Uint8ToInt16(v uint8) int16 {
+	return int16(uint8AsInt64(v))
+}
+
+// Uint8ToInt16E 按 many.ToE[int16] 的规则将 uint8 转换为 int16, 结果为零值或越界时返回错误
+func Uint8ToInt16E(v uint8) (int16, error) {
+	r := Uint8ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to int16")
+	}
+	if uint64(v) > math.MaxInt16 {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Uint8ToInt32 按 many.To[int32] 的规则将 uint8 转换为 int32
+func Uint8ToInt32(v uint8) int32 {
+	return int32(uint8AsInt64(v))
+}
+
+// Uint8ToInt32E 按 many.ToE[int32] 的规则将 uint8 转换为 int32, 结果为零值或越界时返回错误
+func Uint8ToInt32E(v uint8) (int32, error) {
+	r := Uint8ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to int32")
+	}
+	if uint64(v) > math.MaxInt32 {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Uint8ToInt64 按 many.To[int64] 的规则将 uint8 转换为 int64
+func Uint8ToInt64(v uint8) int64 {
+	return uint8AsInt64(v)
+}
+
+// Uint8ToInt64E 按 many.ToE[int64] 的规则将 uint8 转换为 int64, 结果为零值或越界时返回错误
+func Uint8ToInt64E(v uint8) (int64, error) {
+	r := Uint8ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to int64")
+	}
+	if uint64(v) > math.MaxInt64 {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Uint8ToUint 按 many.To[uint] 的规则将 uint8 转换为 uint
+func Uint8ToUint(v uint8) uint {
+	return uint(uint8AsUint64(v))
+}
+
+// Uint8ToUintE 按 many.ToE[uint] 的规则将 uint8 转换为 uint, 结果为零值或越界时返回错误
+func Uint8ToUintE(v uint8) (uint, error) {
+	r := Uint8ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to uint")
+	}
+	if uint64(v) > math.MaxUint {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Uint8ToUint8 按 many.To[uint8] 的规则将 uint8 转换为 uint8
+func Uint8ToUint8(v uint8) uint8 {
+	return uint8(uint8AsUint64(v))
+}
+
+// Uint8ToUint8E 按 many.ToE[uint8] 的规则将 uint8 转换为 uint8, 结果为零值或越界时返回错误
+func Uint8ToUint8E(v uint8) (uint8, error) {
+	r := Uint8ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to uint8")
+	}
+	if uint64(v) > math.MaxUint8 {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Uint8ToUint16 按 many.To[uint16] 的规则将 uint8 转换为 uint16
+func Uint8ToUint16(v uint8) uint16 {
+	return uint16(uint8AsUint64(v))
+}
+
+// Uint8ToUint16E 按 many.ToE[uint16] 的规则将 uint8 转换为 uint16, 结果为零值或越界时返回错误
+func Uint8ToUint16E(v uint8) (uint16, error) {
+	r := Uint8ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to uint16")
+	}
+	if uint64(v) > math.MaxUint16 {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Uint8ToUint32 按 many.To[uint32] 的规则将 uint8 转换为 uint32
+func Uint8ToUint32(v uint8) uint32 {
+	return uint32(uint8AsUint64(v))
+}
+
+// Uint8ToUint32E 按 many.ToE[uint32] 的规则将 uint8 转换为 uint32, 结果为零值或越界时返回错误
+func Uint8ToUint32E(v uint8) (uint32, error) {
+	r := Uint8ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to uint32")
+	}
+	if uint64(v) > math.MaxUint32 {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Uint8ToUint64 按 many.To[uint64] 的规则将 uint8 转换为 uint64
+func Uint8ToUint64(v uint8) uint64 {
+	return uint8AsUint64(v)
+}
+
+// Uint8ToUint64E 按 many.ToE[uint64] 的规则将 uint8 转换为 uint64, 结果为零值或越界时返回错误
+func Uint8ToUint64E(v uint8) (uint64, error) {
+	r := Uint8ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to uint64")
+	}
+	if uint64(v) > math.MaxUint64 {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Uint8ToFloat32 按 many.To[float32] 的规则将 uint8 转换为 float32
+func Uint8ToFloat32(v uint8) float32 {
+	return float32(uint8AsFloat64(v))
+}
+
+// Uint8ToFloat32E 按 many.ToE[float32] 的规则将 uint8 转换为 float32, 结果为零值或越界时返回错误
+func Uint8ToFloat32E(v uint8) (float32, error) {
+	r := Uint8ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to float32")
+	}
+	return r, nil
+}
+
+// Uint8ToFloat64 按 many.To[float64] 的规则将 uint8 转换为 float64
+func Uint8ToFloat64(v uint8) float64 {
+	return uint8AsFloat64(v)
+}
+
+// Uint8ToFloat64E 按 many.ToE[float64] 的规则将 uint8 转换为 float64, 结果为零值或越界时返回错误
+func Uint8ToFloat64E(v uint8) (float64, error) {
+	r := Uint8ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint8 to float64")
+	}
+	return r, nil
+}
+
+// Uint16ToBool 按 many.To[bool] 的规则将 uint16 转换为 bool
+func Uint16ToBool(v uint16) bool {
+	return uint16AsBool(v)
+}
+
+// Uint16ToBoolE 按 many.ToE[bool] 的规则将 uint16 转换为 bool, 结果为零值或越界时返回错误
+func Uint16ToBoolE(v uint16) (bool, error) {
+	r := Uint16ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert uint16 to bool")
+	}
+	return r, nil
+}
+
+// Uint16ToString 按 many.To[string] 的规则将 uint16 转换为 string
+func Uint16ToString(v uint16) string {
+	return uint16AsString(v)
+}
+
+// Uint16ToStringE 按 many.ToE[string] 的规则将 uint16 转换为 string, 结果为零值或越界时返回错误
+func Uint16ToStringE(v uint16) (string, error) {
+	r := Uint16ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert uint16 to string")
+	}
+	return r, nil
+}
+
+// Uint16ToInt 按 many.To[int] 的规则将 uint16 转换为 int
+func Uint16ToInt(v uint16) int {
+	return int(uint16AsInt64(v))
+}
+
+// Uint16ToIntE 按 many.ToE[int] 的规则将 uint16 转换为 int, 结果为零值或越界时返回错误
+func Uint16ToIntE(v uint16) (int, error) {
+	r := Uint16ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to int")
+	}
+	if uint64(v) > math.MaxInt {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Uint16ToInt8 按 many.To[int8] 的规则将 uint16 转换为 int8
+func Uint16ToInt8(v uint16) int8 {
+	return int8(uint16AsInt64(v))
+}
+
+// Uint16ToInt8E 按 many.ToE[int8] 的规则将 uint16 转换为 int8, 结果为零值或越界时返回错误
+func Uint16ToInt8E(v uint16) (int8, error) {
+	r := Uint16ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to int8")
+	}
+	if uint64(v) > math.MaxInt8 {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Uint16ToInt16 按 many.To[int16] 的规则将 uint16 转换为 int16
+func Uint16ToInt16(v uint16) int16 {
+	return int16(uint16AsInt64(v))
+}
+
+// Uint16ToInt16E 按 many.ToE[int16] 的规则将 uint16 转换为 int16, 结果为零值或越界时返回错误
+func Uint16ToInt16E(v uint16) (int16, error) {
+	r := Uint16ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to int16")
+	}
+	if uint64(v) > math.MaxInt16 {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Uint16ToInt32 按 many.To[int32] 的规则将 uint16 转换为 int32
+func Uint16ToInt32(v uint16) int32 {
+	return int32(uint16AsInt64(v))
+}
+
+// Uint16ToInt32E 按 many.ToE[int32] 的规则将 uint16 转换为 int32, 结果为零值或越界时返回错误
+func Uint16ToInt32E(v uint16) (int32, error) {
+	r := Uint16ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to int32")
+	}
+	if uint64(v) > math.MaxInt32 {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Uint16ToInt64 按 many.To[int64] 的规则将 uint16 转换为 int64
+func Uint16ToInt64(v uint16) int64 {
+	return uint16AsInt64(v)
+}
+
+// Uint16ToInt64E 按 many.ToE[int64] 的规则将 uint16 转换为 int64, 结果为零值或越界时返回错误
+func Uint16ToInt64E(v uint16) (int64, error) {
+	r := Uint16ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to int64")
+	}
+	if uint64(v) > math.MaxInt64 {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Uint16ToUint 按 many.To[uint] 的规则将 uint16 转换为 uint
+func Uint16ToUint(v uint16) uint {
+	return uint(uint16AsUint64(v))
+}
+
+// Uint16ToUintE 按 many.ToE[uint] 的规则将 uint16 转换为 uint, 结果为零值或越界时返回错误
+func Uint16ToUintE(v uint16) (uint, error) {
+	r := Uint16ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to uint")
+	}
+	if uint64(v) > math.MaxUint {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Uint16ToUint8 按 many.To[uint8] 的规则将 uint16 转换为 uint8
+func Uint16ToUint8(v uint16) uint8 {
+	return uint8(uint16AsUint64(v))
+}
+
+// Uint16ToUint8E 按 many.ToE[uint8] 的规则将 uint16 转换为 uint8, 结果为零值或越界时返回错误
+func Uint16ToUint8E(v uint16) (uint8, error) {
+	r := Uint16ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to uint8")
+	}
+	if uint64(v) > math.MaxUint8 {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Uint16ToUint16 按 many.To[uint16] 的规则将 uint16 转换为 uint16
+func Uint16ToUint16(v uint16) uint16 {
+	return uint16(uint16AsUint64(v))
+}
+
+// Uint16ToUint16E 按 many.ToE[uint16] 的规则将 uint16 转换为 uint16, 结果为零值或越界时返回错误
+func Uint16ToUint16E(v uint16) (uint16, error) {
+	r := Uint16ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to uint16")
+	}
+	if uint64(v) > math.MaxUint16 {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Uint16ToUint32 按 many.To[uint32] 的规则将 uint16 转换为 uint32
+func Uint16ToUint32(v uint16) uint32 {
+	return uint32(uint16AsUint64(v))
+}
+
+// Uint16ToUint32E 按 many.ToE[uint32] 的规则将 uint16 转换为 uint32, 结果为零值或越界时返回错误
+func Uint16ToUint32E(v uint16) (uint32, error) {
+	r := Uint16ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to uint32")
+	}
+	if uint64(v) > math.MaxUint32 {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Uint16ToUint64 按 many.To[uint64] 的规则将 uint16 转换为 uint64
+func Uint16ToUint64(v uint16) uint64 {
+	return uint16AsUint64(v)
+}
+
+// Uint16ToUint64E 按 many.ToE[uint64] 的规则将 uint16 转换为 uint64, 结果为零值或越界时返回错误
+func Uint16ToUint64E(v uint16) (uint64, error) {
+	r := Uint16ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to uint64")
+	}
+	if uint64(v) > math.MaxUint64 {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Uint16ToFloat32 按 many.To[float32] 的规则将 uint16 转换为 float32
+func Uint16ToFloat32(v uint16) float32 {
+	return float32(uint16AsFloat64(v))
+}
+
+// Uint16ToFloat32E 按 many.ToE[float32] 的规则将 uint16 转换为 float32, 结果为零值或越界时返回错误
+func Uint16ToFloat32E(v uint16) (float32, error) {
+	r := Uint16ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to float32")
+	}
+	return r, nil
+}
+
+// Uint16ToFloat64 按 many.To[float64] 的规则将 uint16 转换为 float64
+func Uint16ToFloat64(v uint16) float64 {
+	return uint16AsFloat64(v)
+}
+
+// Uint16ToFloat64E 按 many.ToE[float64] 的规则将 uint16 转换为 float64, 结果为零值或越界时返回错误
+func Uint16ToFloat64E(v uint16) (float64, error) {
+	r := Uint16ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint16 to float64")
+	}
+	return r, nil
+}
+
+// Uint32ToBool 按 many.To[bool] 的规则将 uint32 转换为 bool
+func Uint32ToBool(v uint32) bool {
+	return uint32AsBool(v)
+}
+
+// Uint32ToBoolE 按 many.ToE[bool] 的规则将 uint32 转换为 bool, 结果为零值或越界时返回错误
+func Uint32ToBoolE(v uint32) (bool, error) {
+	r := Uint32ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert uint32 to bool")
+	}
+	return r, nil
+}
+
+// Uint32ToString 按 many.To[string] 的规则将 uint32 转换为 string
+func Uint32ToString(v uint32) string {
+	return uint32AsString(v)
+}
+
+// Uint32ToStringE 按 many.ToE[string] 的规则将 uint32 转换为 string, 结果为零值或越界时返回错误
+func Uint32ToStringE(v uint32) (string, error) {
+	r := Uint32ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert uint32 to string")
+	}
+	return r, nil
+}
+
+// Uint32ToInt 按 many.To[int] 的规则将 uint32 转换为 int
+func Uint32ToInt(v uint32) int {
+	return int(uint32AsInt64(v))
+}
+
+// Uint32ToIntE 按 many.ToE[int] 的规则将 uint32 转换为 int, 结果为零值或越界时返回错误
+func Uint32ToIntE(v uint32) (int, error) {
+	r := Uint32ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to int")
+	}
+	if uint64(v) > math.MaxInt {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Uint32ToInt8 按 many.To[int8] 的规则将 uint32 转换为 int8
+func Uint32ToInt8(v uint32) int8 {
+	return int8(uint32AsInt64(v))
+}
+
+// Uint32ToInt8E 按 many.ToE[int8] 的规则将 uint32 转换为 int8, 结果为零值或越界时返回错误
+func Uint32ToInt8E(v uint32) (int8, error) {
+	r := Uint32ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to int8")
+	}
+	if uint64(v) > math.MaxInt8 {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Uint32ToInt16 按 many.To[int16] 的规则将 uint32 转换为 int16
+func Uint32ToInt16(v uint32) int16 {
+	return int16(uint32AsInt64(v))
+}
+
+// Uint32ToInt16E 按 many.ToE[int16] 的规则将 uint32 转换为 int16, 结果为零值或越界时返回错误
+func Uint32ToInt16E(v uint32) (int16, error) {
+	r := Uint32ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to int16")
+	}
+	if uint64(v) > math.MaxInt16 {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Uint32ToInt32 按 many.To[int32] 的规则将 uint32 转换为 int32
+func Uint32ToInt32(v uint32) int32 {
+	return int32(uint32AsInt64(v))
+}
+
+// Uint32ToInt32E 按 many.ToE[int32] 的规则将 uint32 转换为 int32, 结果为零值或越界时返回错误
+func Uint32ToInt32E(v uint32) (int32, error) {
+	r := Uint32ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to int32")
+	}
+	if uint64(v) > math.MaxInt32 {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Uint32ToInt64 按 many.To[int64] 的规则将 uint32 转换为 int64
+func Uint32ToInt64(v uint32) int64 {
+	return uint32AsInt64(v)
+}
+
+// Uint32ToInt64E 按 many.ToE[int64] 的规则将 uint32 转换为 int64, 结果为零值或越界时返回错误
+func Uint32ToInt64E(v uint32) (int64, error) {
+	r := Uint32ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to int64")
+	}
+	if uint64(v) > math.MaxInt64 {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Uint32ToUint 按 many.To[uint] 的规则将 uint32 转换为 uint
+func Uint32ToUint(v uint32) uint {
+	return uint(uint32AsUint64(v))
+}
+
+// Uint32ToUintE 按 many.ToE[uint] 的规则将 uint32 转换为 uint, 结果为零值或越界时返回错误
+func Uint32ToUintE(v uint32) (uint, error) {
+	r := Uint32ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to uint")
+	}
+	if uint64(v) > math.MaxUint {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Uint32ToUint8 按 many.To[uint8] 的规则将 uint32 转换为 uint8
+func Uint32ToUint8(v uint32) uint8 {
+	return uint8(uint32AsUint64(v))
+}
+
+// Uint32ToUint8E 按 many.ToE[uint8] 的规则将 uint32 转换为 uint8, 结果为零值或越界时返回错误
+func Uint32ToUint8E(v uint32) (uint8, error) {
+	r := Uint32ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to uint8")
+	}
+	if uint64(v) > math.MaxUint8 {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Uint32ToUint16 按 many.To[uint16] 的规则将 uint32 转换为 uint16
+func Uint32ToUint16(v uint32) uint16 {
+	return uint16(uint32AsUint64(v))
+}
+
+// Uint32ToUint16E 按 many.ToE[uint16] 的规则将 uint32 转换为 uint16, 结果为零值或越界时返回错误
+func Uint32ToUint16E(v uint32) (uint16, error) {
+	r := Uint32ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to uint16")
+	}
+	if uint64(v) > math.MaxUint16 {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Uint32ToUint32 按 many.To[uint32] 的规则将 uint32 转换为 uint32
+func Uint32ToUint32(v uint32) uint32 {
+	return uint32(uint32AsUint64(v))
+}
+
+// Uint32ToUint32E 按 many.ToE[uint32] 的规则将 uint32 转换为 uint32, 结果为零值或越界时返回错误
+func Uint32ToUint32E(v uint32) (uint32, error) {
+	r := Uint32ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to uint32")
+	}
+	if uint64(v) > math.MaxUint32 {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Uint32ToUint64 按 many.To[uint64] 的规则将 uint32 转换为 uint64
+func Uint32ToUint64(v uint32) uint64 {
+	return uint32AsUint64(v)
+}
+
+// Uint32ToUint64E 按 many.ToE[uint64] 的规则将 uint32 转换为 uint64, 结果为零值或越界时返回错误
+func Uint32ToUint64E(v uint32) (uint64, error) {
+	r := Uint32ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to uint64")
+	}
+	if uint64(v) > math.MaxUint64 {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Uint32ToFloat32 按 many.To[float32] 的规则将 uint32 转换为 float32
+func Uint32ToFloat32(v uint32) float32 {
+	return float32(uint32AsFloat64(v))
+}
+
+// Uint32ToFloat32E 按 many.ToE[float32] 的规则将 uint32 转换为 float32, 结果为零值或越界时返回错误
+func Uint32ToFloat32E(v uint32) (float32, error) {
+	r := Uint32ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to float32")
+	}
+	return r, nil
+}
+
+// Uint32ToFloat64 按 many.To[float64] 的规则将 uint32 转换为 float64
+func Uint32ToFloat64(v uint32) float64 {
+	return uint32AsFloat64(v)
+}
+
+// Uint32ToFloat64E 按 many.ToE[float64] 的规则将 uint32 转换为 float64, 结果为零值或越界时返回错误
+func Uint32ToFloat64E(v uint32) (float64, error) {
+	r := Uint32ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint32 to float64")
+	}
+	return r, nil
+}
+
+// Uint64ToBool 按 many.To[bool] 的规则将 uint64 转换为 bool
+func Uint64ToBool(v uint64) bool {
+	return uint64AsBool(v)
+}
+
+// Uint64ToBoolE 按 many.ToE[bool] 的规则将 uint64 转换为 bool, 结果为零值或越界时返回错误
+func Uint64ToBoolE(v uint64) (bool, error) {
+	r := Uint64ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert uint64 to bool")
+	}
+	return r, nil
+}
+
+// Uint64ToString 按 many.To[string] 的规则将 uint64 转换为 string
+func Uint64ToString(v uint64) string {
+	return uint64AsString(v)
+}
+
+// Uint64ToStringE 按 many.ToE[string] 的规则将 uint64 转换为 string, 结果为零值或越界时返回错误
+func Uint64ToStringE(v uint64) (string, error) {
+	r := Uint64ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert uint64 to string")
+	}
+	return r, nil
+}
+
+// Uint64ToInt 按 many.To[int] 的规则将 uint64 转换为 int
+func Uint64ToInt(v uint64) int {
+	return int(uint64AsInt64(v))
+}
+
+// Uint64ToIntE 按 many.ToE[int] 的规则将 uint64 转换为 int, 结果为零值或越界时返回错误
+func Uint64ToIntE(v uint64) (int, error) {
+	r := Uint64ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to int")
+	}
+	if uint64(v) > math.MaxInt {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Uint64ToInt8 按 many.To[int8] 的规则将 uint64 转换为 int8
+func Uint64ToInt8(v uint64) int8 {
+	return int8(uint64AsInt64(v))
+}
+
+// Uint64ToInt8E 按 many.ToE[int8] 的规则将 uint64 转换为 int8, 结果为零值或越界时返回错误
+func Uint64ToInt8E(v uint64) (int8, error) {
+	r := Uint64ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to int8")
+	}
+	if uint64(v) > math.MaxInt8 {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Uint64ToInt16 按 many.To[int16] 的规则将 uint64 转换为 int16
+func Uint64ToInt16(v uint64) int16 {
+	return int16(uint64AsInt64(v))
+}
+
+// Uint64ToInt16E 按 many.ToE[int16] 的规则将 uint64 转换为 int16, 结果为零值或越界时返回错误
+func Uint64ToInt16E(v uint64) (int16, error) {
+	r := Uint64ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to int16")
+	}
+	if uint64(v) > math.MaxInt16 {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Uint64ToInt32 按 many.To[int32] 的规则将 uint64 转换为 int32
+func Uint64ToInt32(v uint64) int32 {
+	return int32(uint64AsInt64(v))
+}
+
+// Uint64ToInt32E 按 many.ToE[int32] 的规则将 uint64 转换为 int32, 结果为零值或越界时返回错误
+func Uint64ToInt32E(v uint64) (int32, error) {
+	r := Uint64ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to int32")
+	}
+	if uint64(v) > math.MaxInt32 {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Uint64ToInt64 按 many.To[int64] 的规则将 uint64 转换为 int64
+func Uint64ToInt64(v uint64) int64 {
+	return uint64AsInt64(v)
+}
+
+// Uint64ToInt64E 按 many.ToE[int64] 的规则将 uint64 转换为 int64, 结果为零值或越界时返回错误
+func Uint64ToInt64E(v uint64) (int64, error) {
+	r := Uint64ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to int64")
+	}
+	if uint64(v) > math.MaxInt64 {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Uint64ToUint 按 many.To[uint] 的规则将 uint64 转换为 uint
+func Uint64ToUint(v uint64) uint {
+	return uint(uint64AsUint64(v))
+}
+
+// Uint64ToUintE 按 many.ToE[uint] 的规则将 uint64 转换为 uint, 结果为零值或越界时返回错误
+func Uint64ToUintE(v uint64) (uint, error) {
+	r := Uint64ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to uint")
+	}
+	if uint64(v) > math.MaxUint {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Uint64ToUint8 按 many.To[uint8] 的规则将 uint64 转换为 uint8
+func Uint64ToUint8(v uint64) uint8 {
+	return uint8(uint64AsUint64(v))
+}
+
+// Uint64ToUint8E 按 many.ToE[uint8] 的规则将 uint64 转换为 uint8, 结果为零值或越界时返回错误
+func Uint64ToUint8E(v uint64) (uint8, error) {
+	r := Uint64ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to uint8")
+	}
+	if uint64(v) > math.MaxUint8 {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Uint64ToUint16 按 many.To[uint16] 的规则将 uint64 转换为 uint16
+func Uint64ToUint16(v uint64) uint16 {
+	return uint16(uint64AsUint64(v))
+}
+
+// Uint64ToUint16E 按 many.ToE[uint16] 的规则将 uint64 转换为 uint16, 结果为零值或越界时返回错误
+func Uint64ToUint16E(v uint64) (uint16, error) {
+	r := Uint64ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to uint16")
+	}
+	if uint64(v) > math.MaxUint16 {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Uint64ToUint32 按 many.To[uint32] 的规则将 uint64 转换为 uint32
+func Uint64ToUint32(v uint64) uint32 {
+	return uint32(uint64AsUint64(v))
+}
+
+// Uint64ToUint32E 按 many.ToE[uint32] 的规则将 uint64 转换为 uint32, 结果为零值或越界时返回错误
+func Uint64ToUint32E(v uint64) (uint32, error) {
+	r := Uint64ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to uint32")
+	}
+	if uint64(v) > math.MaxUint32 {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Uint64ToUint64 按 many.To[uint64] 的规则将 uint64 转换为 uint64
+func Uint64ToUint64(v uint64) uint64 {
+	return uint64AsUint64(v)
+}
+
+// Uint64ToUint64E 按 many.ToE[uint64] 的规则将 uint64 转换为 uint64, 结果为零值或越界时返回错误
+func Uint64ToUint64E(v uint64) (uint64, error) {
+	r := Uint64ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to uint64")
+	}
+	if uint64(v) > math.MaxUint64 {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Uint64ToFloat32 按 many.To[float32] 的规则将 uint64 转换为 float32
+func Uint64ToFloat32(v uint64) float32 {
+	return float32(uint64AsFloat64(v))
+}
+
+// Uint64ToFloat32E 按 many.ToE[float32] 的规则将 uint64 转换为 float32, 结果为零值或越界时返回错误
+func Uint64ToFloat32E(v uint64) (float32, error) {
+	r := Uint64ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to float32")
+	}
+	return r, nil
+}
+
+// Uint64ToFloat64 按 many.To[float64] 的规则将 uint64 转换为 float64
+func Uint64ToFloat64(v uint64) float64 {
+	return uint64AsFloat64(v)
+}
+
+// Uint64ToFloat64E 按 many.ToE[float64] 的规则将 uint64 转换为 float64, 结果为零值或越界时返回错误
+func Uint64ToFloat64E(v uint64) (float64, error) {
+	r := Uint64ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert uint64 to float64")
+	}
+	return r, nil
+}
+
+// Float32ToBool 按 many.To[bool] 的规则将 float32 转换为 bool
+func Float32ToBool(v float32) bool {
+	return float32AsBool(v)
+}
+
+// Float32ToBoolE 按 many.ToE[bool] 的规则将 float32 转换为 bool, 结果为零值或越界时返回错误
+func Float32ToBoolE(v float32) (bool, error) {
+	r := Float32ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert float32 to bool")
+	}
+	return r, nil
+}
+
+// Float32ToString 按 many.To[string] 的规则将 float32 转换为 string
+func Float32ToString(v float32) string {
+	return float32AsString(v)
+}
+
+// Float32ToStringE 按 many.ToE[string] 的规则将 float32 转换为 string, 结果为零值或越界时返回错误
+func Float32ToStringE(v float32) (string, error) {
+	r := Float32ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert float32 to string")
+	}
+	return r, nil
+}
+
+// Float32ToInt 按 many.To[int] 的规则将 float32 转换为 int
+func Float32ToInt(v float32) int {
+	return int(float32AsInt64(v))
+}
+
+// Float32ToIntE 按 many.ToE[int] 的规则将 float32 转换为 int, 结果为零值或越界时返回错误
+func Float32ToIntE(v float32) (int, error) {
+	r := Float32ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to int")
+	}
+	if overflowsFloat(float64(v), math.MinInt, math.MaxInt) {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Float32ToInt8 按 many.To[int8] 的规则将 float32 转换为 int8
+func Float32ToInt8(v float32) int8 {
+	return int8(float32AsInt64(v))
+}
+
+// Float32ToInt8E 按 many.ToE[int8] 的规则将 float32 转换为 int8, 结果为零值或越界时返回错误
+func Float32ToInt8E(v float32) (int8, error) {
+	r := Float32ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to int8")
+	}
+	if overflowsFloat(float64(v), math.MinInt8, math.MaxInt8) {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Float32ToInt16 按 many.To[int16] 的规则将 float32 转换为 int16
+func Float32ToInt16(v float32) int16 {
+	return int16(float32AsInt64(v))
+}
+
+// Float32ToInt16E 按 many.ToE[int16] 的规则将 float32 转换为 int16, 结果为零值或越界时返回错误
+func Float32ToInt16E(v float32) (int16, error) {
+	r := Float32ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to int16")
+	}
+	if overflowsFloat(float64(v), math.MinInt16, math.MaxInt16) {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Float32ToInt32 按 many.To[int32] 的规则将 float32 转换为 int32
+func Float32ToInt32(v float32) int32 {
+	return int32(float32AsInt64(v))
+}
+
+// Float32ToInt32E 按 many.ToE[int32] 的规则将 float32 转换为 int32, 结果为零值或越界时返回错误
+func Float32ToInt32E(v float32) (int32, error) {
+	r := Float32ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to int32")
+	}
+	if overflowsFloat(float64(v), math.MinInt32, math.MaxInt32) {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Float32ToInt64 按 many.To[int64] 的规则将 float32 转换为 int64
+func Float32ToInt64(v float32) int64 {
+	return float32AsInt64(v)
+}
+
+// Float32ToInt64E 按 many.ToE[int64] 的规则将 float32 转换为 int64, 结果为零值或越界时返回错误
+func Float32ToInt64E(v float32) (int64, error) {
+	r := Float32ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to int64")
+	}
+	if overflowsFloat(float64(v), math.MinInt64, math.MaxInt64) {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Float32ToUint 按 many.To[uint] 的规则将 float32 转换为 uint
+func Float32ToUint(v float32) uint {
+	return uint(float32AsUint64(v))
+}
+
+// Float32ToUintE 按 many.ToE[uint] 的规则将 float32 转换为 uint, 结果为零值或越界时返回错误
+func Float32ToUintE(v float32) (uint, error) {
+	r := Float32ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to uint")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint) {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Float32ToUint8 按 many.To[uint8] 的规则将 float32 转换为 uint8
+func Float32ToUint8(v float32) uint8 {
+	return uint8(float32AsUint64(v))
+}
+
+// Float32ToUint8E 按 many.ToE[uint8] 的规则将 float32 转换为 uint8, 结果为零值或越界时返回错误
+func Float32ToUint8E(v float32) (uint8, error) {
+	r := Float32ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to uint8")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint8) {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Float32ToUint16 按 many.To[uint16] 的规则将 float32 转换为 uint16
+func Float32ToUint16(v float32) uint16 {
+	return uint16(float32AsUint64(v))
+}
+
+// Float32ToUint16E 按 many.ToE[uint16] 的规则将 float32 转换为 uint16, 结果为零值或越界时返回错误
+func Float32ToUint16E(v float32) (uint16, error) {
+	r := Float32ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to uint16")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint16) {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Float32ToUint32 按 many.To[uint32] 的规则将 float32 转换为 uint32
+func Float32ToUint32(v float32) uint32 {
+	return uint32(float32AsUint64(v))
+}
+
+// Float32ToUint32E 按 many.ToE[uint32] 的规则将 float32 转换为 uint32, 结果为零值或越界时返回错误
+func Float32ToUint32E(v float32) (uint32, error) {
+	r := Float32ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to uint32")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint32) {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Float32ToUint64 按 many.To[uint64] 的规则将 float32 转换为 uint64
+func Float32ToUint64(v float32) uint64 {
+	return float32AsUint64(v)
+}
+
+// Float32ToUint64E 按 many.ToE[uint64] 的规则将 float32 转换为 uint64, 结果为零值或越界时返回错误
+func Float32ToUint64E(v float32) (uint64, error) {
+	r := Float32ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to uint64")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint64) {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Float32ToFloat32 按 many.To[float32] 的规则将 float32 转换为 float32
+func Float32ToFloat32(v float32) float32 {
+	return float32(float32AsFloat64(v))
+}
+
+// Float32ToFloat32E 按 many.ToE[float32] 的规则将 float32 转换为 float32, 结果为零值或越界时返回错误
+func Float32ToFloat32E(v float32) (float32, error) {
+	r := Float32ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to float32")
+	}
+	return r, nil
+}
+
+// Float32ToFloat64 按 many.To[float64] 的规则将 float32 转换为 float64
+func Float32ToFloat64(v float32) float64 {
+	return float32AsFloat64(v)
+}
+
+// Float32ToFloat64E 按 many.ToE[float64] 的规则将 float32 转换为 float64, 结果为零值或越界时返回错误
+func Float32ToFloat64E(v float32) (float64, error) {
+	r := Float32ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float32 to float64")
+	}
+	return r, nil
+}
+
+// Float64ToBool 按 many.To[bool] 的规则将 float64 转换为 bool
+func Float64ToBool(v float64) bool {
+	return float64AsBool(v)
+}
+
+// Float64ToBoolE 按 many.ToE[bool] 的规则将 float64 转换为 bool, 结果为零值或越界时返回错误
+func Float64ToBoolE(v float64) (bool, error) {
+	r := Float64ToBool(v)
+	if !r {
+		return false, errors.New("cannot convert float64 to bool")
+	}
+	return r, nil
+}
+
+// Float64ToString 按 many.To[string] 的规则将 float64 转换为 string
+func Float64ToString(v float64) string {
+	return float64AsString(v)
+}
+
+// Float64ToStringE 按 many.ToE[string] 的规则将 float64 转换为 string, 结果为零值或越界时返回错误
+func Float64ToStringE(v float64) (string, error) {
+	r := Float64ToString(v)
+	if r == "" {
+		return "", errors.New("cannot convert float64 to string")
+	}
+	return r, nil
+}
+
+// Float64ToInt 按 many.To[int] 的规则将 float64 转换为 int
+func Float64ToInt(v float64) int {
+	return int(float64AsInt64(v))
+}
+
+// Float64ToIntE 按 many.ToE[int] 的规则将 float64 转换为 int, 结果为零值或越界时返回错误
+func Float64ToIntE(v float64) (int, error) {
+	r := Float64ToInt(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to int")
+	}
+	if overflowsFloat(float64(v), math.MinInt, math.MaxInt) {
+		return 0, fmt.Errorf("value %v overflows int", v)
+	}
+	return r, nil
+}
+
+// Float64ToInt8 按 many.To[int8] 的规则将 float64 转换为 int8
+func Float64ToInt8(v float64) int8 {
+	return int8(float64AsInt64(v))
+}
+
+// Float64ToInt8E 按 many.ToE[int8] 的规则将 float64 转换为 int8, 结果为零值或越界时返回错误
+func Float64ToInt8E(v float64) (int8, error) {
+	r := Float64ToInt8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to int8")
+	}
+	if overflowsFloat(float64(v), math.MinInt8, math.MaxInt8) {
+		return 0, fmt.Errorf("value %v overflows int8", v)
+	}
+	return r, nil
+}
+
+// Float64ToInt16 按 many.To[int16] 的规则将 float64 转换为 int16
+func Float64ToInt16(v float64) int16 {
+	return int16(float64AsInt64(v))
+}
+
+// Float64ToInt16E 按 many.ToE[int16] 的规则将 float64 转换为 int16, 结果为零值或越界时返回错误
+func Float64ToInt16E(v float64) (int16, error) {
+	r := Float64ToInt16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to int16")
+	}
+	if overflowsFloat(float64(v), math.MinInt16, math.MaxInt16) {
+		return 0, fmt.Errorf("value %v overflows int16", v)
+	}
+	return r, nil
+}
+
+// Float64ToInt32 按 many.To[int32] 的规则将 float64 转换为 int32
+func Float64ToInt32(v float64) int32 {
+	return int32(float64AsInt64(v))
+}
+
+// Float64ToInt32E 按 many.ToE[int32] 的规则将 float64 转换为 int32, 结果为零值或越界时返回错误
+func Float64ToInt32E(v float64) (int32, error) {
+	r := Float64ToInt32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to int32")
+	}
+	if overflowsFloat(float64(v), math.MinInt32, math.MaxInt32) {
+		return 0, fmt.Errorf("value %v overflows int32", v)
+	}
+	return r, nil
+}
+
+// Float64ToInt64 按 many.To[int64] 的规则将 float64 转换为 int64
+func Float64ToInt64(v float64) int64 {
+	return float64AsInt64(v)
+}
+
+// Float64ToInt64E 按 many.ToE[int64] 的规则将 float64 转换为 int64, 结果为零值或越界时返回错误
+func Float64ToInt64E(v float64) (int64, error) {
+	r := Float64ToInt64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to int64")
+	}
+	if overflowsFloat(float64(v), math.MinInt64, math.MaxInt64) {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return r, nil
+}
+
+// Float64ToUint 按 many.To[uint] 的规则将 float64 转换为 uint
+func Float64ToUint(v float64) uint {
+	return uint(float64AsUint64(v))
+}
+
+// Float64ToUintE 按 many.ToE[uint] 的规则将 float64 转换为 uint, 结果为零值或越界时返回错误
+func Float64ToUintE(v float64) (uint, error) {
+	r := Float64ToUint(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to uint")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint) {
+		return 0, fmt.Errorf("value %v overflows uint", v)
+	}
+	return r, nil
+}
+
+// Float64ToUint8 按 many.To[uint8] 的规则将 float64 转换为 uint8
+func Float64ToUint8(v float64) uint8 {
+	return uint8(float64AsUint64(v))
+}
+
+// Float64ToUint8E 按 many.ToE[uint8] 的规则将 float64 转换为 uint8, 结果为零值或越界时返回错误
+func Float64ToUint8E(v float64) (uint8, error) {
+	r := Float64ToUint8(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to uint8")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint8) {
+		return 0, fmt.Errorf("value %v overflows uint8", v)
+	}
+	return r, nil
+}
+
+// Float64ToUint16 按 many.To[uint16] 的规则将 float64 转换为 uint16
+func Float64ToUint16(v float64) uint16 {
+	return uint16(float64AsUint64(v))
+}
+
+// Float64ToUint16E 按 many.ToE[uint16] 的规则将 float64 转换为 uint16, 结果为零值或越界时返回错误
+func Float64ToUint16E(v float64) (uint16, error) {
+	r := Float64ToUint16(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to uint16")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint16) {
+		return 0, fmt.Errorf("value %v overflows uint16", v)
+	}
+	return r, nil
+}
+
+// Float64ToUint32 按 many.To[uint32] 的规则将 float64 转换为 uint32
+func Float64ToUint32(v float64) uint32 {
+	return uint32(float64AsUint64(v))
+}
+
+// Float64ToUint32E 按 many.ToE[uint32] 的规则将 float64 转换为 uint32, 结果为零值或越界时返回错误
+func Float64ToUint32E(v float64) (uint32, error) {
+	r := Float64ToUint32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to uint32")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint32) {
+		return 0, fmt.Errorf("value %v overflows uint32", v)
+	}
+	return r, nil
+}
+
+// Float64ToUint64 按 many.To[uint64] 的规则将 float64 转换为 uint64
+func Float64ToUint64(v float64) uint64 {
+	return float64AsUint64(v)
+}
+
+// Float64ToUint64E 按 many.ToE[uint64] 的规则将 float64 转换为 uint64, 结果为零值或越界时返回错误
+func Float64ToUint64E(v float64) (uint64, error) {
+	r := Float64ToUint64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to uint64")
+	}
+	if overflowsFloat(float64(v), 0, math.MaxUint64) {
+		return 0, fmt.Errorf("value %v overflows uint64", v)
+	}
+	return r, nil
+}
+
+// Float64ToFloat32 按 many.To[float32] 的规则将 float64 转换为 float32
+func Float64ToFloat32(v float64) float32 {
+	return float32(float64AsFloat64(v))
+}
+
+// Float64ToFloat32E 按 many.ToE[float32] 的规则将 float64 转换为 float32, 结果为零值或越界时返回错误
+func Float64ToFloat32E(v float64) (float32, error) {
+	r := Float64ToFloat32(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to float32")
+	}
+	if overflowsFloat32(v) {
+		return 0, fmt.Errorf("value %v overflows float32", v)
+	}
+	return r, nil
+}
+
+// Float64ToFloat64 按 many.To[float64] 的规则将 float64 转换为 float64
+func Float64ToFloat64(v float64) float64 {
+	return float64AsFloat64(v)
+}
+
+// Float64ToFloat64E 按 many.ToE[float64] 的规则将 float64 转换为 float64, 结果为零值或越界时返回错误
+func Float64ToFloat64E(v float64) (float64, error) {
+	r := Float64ToFloat64(v)
+	if r == 0 {
+		return 0, errors.New("cannot convert float64 to float64")
+	}
+	return r, nil
+}
+
+func boolAsBool(v bool) bool {
+	return v
+}
+
+func boolAsString(v bool) string {
+	if v {
+		return "true"
+	}
+	return "false"
+}
+
+func boolAsInt64(v bool) int64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+func boolAsUint64(v bool) uint64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+func boolAsFloat64(v bool) float64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+func stringAsBool(v string) bool {
+	switch v {
+	case "1", "t", "T", "true", "TRUE", "True", "yes", "YES", "Yes", "y", "Y", "on", "ON", "On":
+		return true
+	default:
+		return false
+	}
+}
+
+func stringAsString(v string) string {
+	return v
+}
+
+func stringAsInt64(v string) int64 {
+	if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(v, 64); err == nil {
+		return floatToInt64(f)
+	}
+	return 0
+}
+
+func stringAsUint64(v string) uint64 {
+	if u, err := strconv.ParseUint(v, 10, 64); err == nil {
+		return u
+	}
+	if f, err := strconv.ParseFloat(v, 64); err == nil {
+		return floatToUint64(f)
+	}
+	return 0
+}
+
+func stringAsFloat64(v string) float64 {
+	if f, err := strconv.ParseFloat(v, 64); err == nil {
+		return f
+	}
+	return 0
+}
+
+func intAsBool(v int) bool {
+	return v == 1
+}
+
+func intAsString(v int) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func intAsInt64(v int) int64 {
+	return int64(v)
+}
+
+func intAsUint64(v int) uint64 {
+	if v < 0 {
+		return 0
+	}
+	return uint64(v)
+}
+
+func intAsFloat64(v int) float64 {
+	return float64(v)
+}
+
+func int8AsBool(v int8) bool {
+	return v == 1
+}
+
+func int8AsString(v int8) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func int8AsInt64(v int8) int64 {
+	return int64(v)
+}
+
+func int8AsUint64(v int8) uint64 {
+	if v < 0 {
+		return 0
+	}
+	return uint64(v)
+}
+
+func int8AsFloat64(v int8) float64 {
+	return float64(v)
+}
+
+func int16AsBool(v int16) bool {
+	return v == 1
+}
+
+func int16AsString(v int16) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func int16AsInt64(v int16) int64 {
+	return int64(v)
+}
+
+func int16AsUint64(v int16) uint64 {
+	if v < 0 {
+		return 0
+	}
+	return uint64(v)
+}
+
+func int16AsFloat64(v int16) float64 {
+	return float64(v)
+}
+
+func int32AsBool(v int32) bool {
+	return v == 1
+}
+
+func int32AsString(v int32) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func int32AsInt64(v int32) int64 {
+	return int64(v)
+}
+
+func int32AsUint64(v int32) uint64 {
+	if v < 0 {
+		return 0
+	}
+	return uint64(v)
+}
+
+func int32AsFloat64(v int32) float64 {
+	return float64(v)
+}
+
+func int64AsBool(v int64) bool {
+	return v == 1
+}
+
+func int64AsString(v int64) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func int64AsInt64(v int64) int64 {
+	return int64(v)
+}
+
+func int64AsUint64(v int64) uint64 {
+	if v < 0 {
+		return 0
+	}
+	return uint64(v)
+}
+
+func int64AsFloat64(v int64) float64 {
+	return float64(v)
+}
+
+func uintAsBool(v uint) bool {
+	return v == 1
+}
+
+func uintAsString(v uint) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+func uintAsInt64(v uint) int64 {
+	return int64(v)
+}
+
+func uintAsUint64(v uint) uint64 {
+	return uint64(v)
+}
+
+func uintAsFloat64(v uint) float64 {
+	return float64(v)
+}
+
+func uint8AsBool(v uint8) bool {
+	return v == 1
+}
+
+func uint8AsString(v uint8) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+func uint8AsInt64(v uint8) int64 {
+	return int64(v)
+}
+
+func uint8AsUint64(v uint8) uint64 {
+	return uint64(v)
+}
+
+func uint8AsFloat64(v uint8) float64 {
+	return float64(v)
+}
+
+func uint16AsBool(v uint16) bool {
+	return v == 1
+}
+
+func uint16AsString(v uint16) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+func uint16AsInt64(v uint16) int64 {
+	return int64(v)
+}
+
+func uint16AsUint64(v uint16) uint64 {
+	return uint64(v)
+}
+
+func uint16AsFloat64(v uint16) float64 {
+	return float64(v)
+}
+
+func uint32AsBool(v uint32) bool {
+	return v == 1
+}
+
+func uint32AsString(v uint32) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+func uint32AsInt64(v uint32) int64 {
+	return int64(v)
+}
+
+func uint32AsUint64(v uint32) uint64 {
+	return uint64(v)
+}
+
+func uint32AsFloat64(v uint32) float64 {
+	return float64(v)
+}
+
+func uint64AsBool(v uint64) bool {
+	return v == 1
+}
+
+func uint64AsString(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
+
+func uint64AsInt64(v uint64) int64 {
+	if v > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(v)
+}
+
+func uint64AsUint64(v uint64) uint64 {
+	return v
+}
+
+func uint64AsFloat64(v uint64) float64 {
+	return float64(v)
+}
+
+func float32AsBool(v float32) bool {
+	return v == 1.0
+}
+
+func float32AsString(v float32) string {
+	return formatFloat(float64(v), 32)
+}
+
+func float32AsInt64(v float32) int64 {
+	return floatToInt64(float64(v))
+}
+
+func float32AsUint64(v float32) uint64 {
+	return floatToUint64(float64(v))
+}
+
+func float32AsFloat64(v float32) float64 {
+	return float64(v)
+}
+
+func float64AsBool(v float64) bool {
+	return v == 1.0
+}
+
+func float64AsString(v float64) string {
+	return formatFloat(v, 64)
+}
+
+func float64AsInt64(v float64) int64 {
+	return floatToInt64(v)
+}
+
+func float64AsUint64(v float64) uint64 {
+	return floatToUint64(v)
+}
+
+func float64AsFloat64(v float64) float64 {
+	return v
+}
+
+// floatToInt64 截断小数部分, 超出 int64 范围时取边界值, NaN 返回 0
+func floatToInt64(f float64) int64 {
+	switch {
+	case f != f:
+		return 0
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	default:
+		return int64(f)
+	}
+}
+
+// floatToUint64 截断小数部分, 负数返回 0, 超出 uint64 范围时取最大值, NaN 返回 0
+func floatToUint64(f float64) uint64 {
+	switch {
+	case f != f || f < 0:
+		return 0
+	case f >= math.MaxUint64:
+		return math.MaxUint64
+	default:
+		return uint64(f)
+	}
+}
+
+// formatFloat 整数形式不带小数点, 否则使用能解析回原值的最短小数形式
+func formatFloat(f float64, bitSize int) string {
+	if math.Floor(f) == f && f >= math.MinInt64 && f < math.MaxInt64 {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return strconv.FormatFloat(f, 'f', -1, bitSize)
+}
+
+// overflowsString 判断字符串的数值是否超出 [lo, hi], 无法解析时返回 false
+func overflowsString(s string, lo int64, hi uint64) bool {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return overflowsInt(i, lo, hi)
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return u > hi
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil && f == 0 {
+		return false
+	}
+	return overflowsFloat(f, lo, hi)
+}
+
+// overflowsInt 判断 i 是否超出 [lo, hi]
+func overflowsInt(i int64, lo int64, hi uint64) bool {
+	if i < 0 {
+		return i < lo
+	}
+	return uint64(i) > hi
+}
+
+// overflowsFloat 判断 f 截断后是否超出 [lo, hi], NaN 视为越界
+func overflowsFloat(f float64, lo int64, hi uint64) bool {
+	if math.IsNaN(f) {
+		return true
+	}
+	f = math.Trunc(f)
+	return f < float64(lo) || f >= float64(hi)+1
+}
+
+// overflowsFloat32 有限的 f 转换为 float32 后变为 ±Inf 视为越界
+func overflowsFloat32(f float64) bool {
+	return !math.IsInf(f, 0) && math.IsInf(float64(float32(f)), 0)
+}
@@ -0,0 +1,2575 @@
+// Code generated by manygen; DO NOT EDIT.
+
+package fastconv
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lwmacct/250300-go-mod-many/pkg/many"
+)
+
+func TestConformance(t *testing.T) {
+	samplesBool := []bool{true, false}
+	samplesString := []string{"", "0", "1", "-1", "2", "123", "-123", "123.45", "-0.5", "1e3", "abc", "true", "yes", "on", "off", "300", "-300", "65536", "99999999999999999999", "18446744073709551615", "1e20", "-1e20", "3.5e38", "1e400"}
+	samplesInt := []int{0, 1, -1, 2, 300, -300, math.MaxInt, math.MinInt}
+	samplesInt8 := []int8{0, 1, -1, 2, math.MaxInt8, math.MinInt8}
+	samplesInt16 := []int16{0, 1, -1, 2, 300, -300, math.MaxInt16, math.MinInt16}
+	samplesInt32 := []int32{0, 1, -1, 2, 300, -300, math.MaxInt32, math.MinInt32}
+	samplesInt64 := []int64{0, 1, -1, 2, 300, -300, math.MaxInt64, math.MinInt64}
+	samplesUint := []uint{0, 1, 2, 300, math.MaxUint}
+	samplesUint8 := []uint8{0, 1, 2, math.MaxUint8}
+	samplesUint16 := []uint16{0, 1, 2, 300, math.MaxUint16}
+	samplesUint32 := []uint32{0, 1, 2, 300, math.MaxUint32}
+	samplesUint64 := []uint64{0, 1, 2, 300, math.MaxInt64, math.MaxUint64}
+	samplesFloat32 := []float32{0, 1, -1, 0.5, 2.25, 123.45, -123.45, 1e10, -1e10}
+	samplesFloat64 := []float64{0, 1, -1, 0.5, 2.25, 123.45, -123.45, 1e10, -1e10, 1e20, 3.5e38, 1e300, math.Inf(1), math.Inf(-1)}
+
+	t.Run("BoolToBool", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToBool(v), many.To[bool](v); got != want {
+				t.Errorf("BoolToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToString", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToString(v), many.To[string](v); got != want {
+				t.Errorf("BoolToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToInt", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToInt(v), many.To[int](v); got != want {
+				t.Errorf("BoolToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToInt8", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("BoolToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToInt16", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("BoolToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToInt32", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("BoolToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToInt64", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("BoolToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToUint", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToUint(v), many.To[uint](v); got != want {
+				t.Errorf("BoolToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToUint8", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("BoolToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToUint16", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("BoolToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToUint32", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("BoolToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToUint64", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("BoolToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToFloat32", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("BoolToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("BoolToFloat64", func(t *testing.T) {
+		for _, v := range samplesBool {
+			if got, want := BoolToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("BoolToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := BoolToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("BoolToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToBool", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToBool(v), many.To[bool](v); got != want {
+				t.Errorf("StringToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToString", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToString(v), many.To[string](v); got != want {
+				t.Errorf("StringToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToInt", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToInt(v), many.To[int](v); got != want {
+				t.Errorf("StringToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToInt8", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("StringToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToInt16", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("StringToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToInt32", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("StringToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToInt64", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("StringToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToUint", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToUint(v), many.To[uint](v); got != want {
+				t.Errorf("StringToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToUint8", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("StringToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToUint16", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("StringToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToUint32", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("StringToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToUint64", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("StringToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToFloat32", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("StringToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("StringToFloat64", func(t *testing.T) {
+		for _, v := range samplesString {
+			if got, want := StringToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("StringToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := StringToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("StringToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToBool", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToBool(v), many.To[bool](v); got != want {
+				t.Errorf("IntToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToString", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToString(v), many.To[string](v); got != want {
+				t.Errorf("IntToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToInt", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToInt(v), many.To[int](v); got != want {
+				t.Errorf("IntToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToInt8", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("IntToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToInt16", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("IntToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToInt32", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("IntToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToInt64", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("IntToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToUint", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToUint(v), many.To[uint](v); got != want {
+				t.Errorf("IntToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToUint8", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("IntToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToUint16", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("IntToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToUint32", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("IntToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToUint64", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("IntToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToFloat32", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("IntToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("IntToFloat64", func(t *testing.T) {
+		for _, v := range samplesInt {
+			if got, want := IntToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("IntToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := IntToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("IntToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToBool", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Int8ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToString", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToString(v), many.To[string](v); got != want {
+				t.Errorf("Int8ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToInt", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Int8ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToInt8", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Int8ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToInt16", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Int8ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToInt32", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Int8ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToInt64", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Int8ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToUint", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Int8ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToUint8", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Int8ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToUint16", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Int8ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToUint32", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Int8ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToUint64", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Int8ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToFloat32", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Int8ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int8ToFloat64", func(t *testing.T) {
+		for _, v := range samplesInt8 {
+			if got, want := Int8ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Int8ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int8ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int8ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToBool", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Int16ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToString", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToString(v), many.To[string](v); got != want {
+				t.Errorf("Int16ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToInt", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Int16ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToInt8", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Int16ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToInt16", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Int16ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToInt32", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Int16ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToInt64", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Int16ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToUint", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Int16ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToUint8", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Int16ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToUint16", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Int16ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToUint32", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Int16ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToUint64", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Int16ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToFloat32", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Int16ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int16ToFloat64", func(t *testing.T) {
+		for _, v := range samplesInt16 {
+			if got, want := Int16ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Int16ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int16ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int16ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToBool", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Int32ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToString", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToString(v), many.To[string](v); got != want {
+				t.Errorf("Int32ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToInt", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Int32ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToInt8", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Int32ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToInt16", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Int32ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToInt32", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Int32ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToInt64", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Int32ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToUint", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Int32ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToUint8", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Int32ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToUint16", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Int32ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToUint32", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Int32ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToUint64", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Int32ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToFloat32", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Int32ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int32ToFloat64", func(t *testing.T) {
+		for _, v := range samplesInt32 {
+			if got, want := Int32ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Int32ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int32ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int32ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToBool", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Int64ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToString", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToString(v), many.To[string](v); got != want {
+				t.Errorf("Int64ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToInt", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Int64ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToInt8", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Int64ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToInt16", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Int64ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToInt32", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Int64ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToInt64", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Int64ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToUint", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Int64ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToUint8", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Int64ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToUint16", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Int64ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToUint32", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Int64ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToUint64", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Int64ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToFloat32", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Int64ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Int64ToFloat64", func(t *testing.T) {
+		for _, v := range samplesInt64 {
+			if got, want := Int64ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Int64ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Int64ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Int64ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToBool", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToBool(v), many.To[bool](v); got != want {
+				t.Errorf("UintToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToString", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToString(v), many.To[string](v); got != want {
+				t.Errorf("UintToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToInt", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToInt(v), many.To[int](v); got != want {
+				t.Errorf("UintToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToInt8", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("UintToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToInt16", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("UintToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToInt32", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("UintToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToInt64", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("UintToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToUint", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToUint(v), many.To[uint](v); got != want {
+				t.Errorf("UintToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToUint8", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("UintToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToUint16", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("UintToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToUint32", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("UintToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToUint64", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("UintToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToFloat32", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("UintToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("UintToFloat64", func(t *testing.T) {
+		for _, v := range samplesUint {
+			if got, want := UintToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("UintToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := UintToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("UintToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToBool", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Uint8ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToString", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToString(v), many.To[string](v); got != want {
+				t.Errorf("Uint8ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToInt", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Uint8ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToInt8", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Uint8ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToInt16", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Uint8ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToInt32", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Uint8ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToInt64", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Uint8ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToUint", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Uint8ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToUint8", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Uint8ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToUint16", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Uint8ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToUint32", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Uint8ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToUint64", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Uint8ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToFloat32", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Uint8ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint8ToFloat64", func(t *testing.T) {
+		for _, v := range samplesUint8 {
+			if got, want := Uint8ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Uint8ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint8ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint8ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToBool", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Uint16ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToString", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToString(v), many.To[string](v); got != want {
+				t.Errorf("Uint16ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToInt", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Uint16ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToInt8", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Uint16ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToInt16", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Uint16ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToInt32", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Uint16ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToInt64", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Uint16ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToUint", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Uint16ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToUint8", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Uint16ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToUint16", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Uint16ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToUint32", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Uint16ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToUint64", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Uint16ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToFloat32", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Uint16ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint16ToFloat64", func(t *testing.T) {
+		for _, v := range samplesUint16 {
+			if got, want := Uint16ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Uint16ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint16ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint16ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToBool", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Uint32ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToString", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToString(v), many.To[string](v); got != want {
+				t.Errorf("Uint32ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToInt", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Uint32ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToInt8", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Uint32ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToInt16", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Uint32ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToInt32", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Uint32ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToInt64", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Uint32ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToUint", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Uint32ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToUint8", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Uint32ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToUint16", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Uint32ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToUint32", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Uint32ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToUint64", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Uint32ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToFloat32", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Uint32ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint32ToFloat64", func(t *testing.T) {
+		for _, v := range samplesUint32 {
+			if got, want := Uint32ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Uint32ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint32ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint32ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToBool", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Uint64ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToString", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToString(v), many.To[string](v); got != want {
+				t.Errorf("Uint64ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToInt", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Uint64ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToInt8", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Uint64ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToInt16", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Uint64ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToInt32", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Uint64ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToInt64", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Uint64ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToUint", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Uint64ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToUint8", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Uint64ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToUint16", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Uint64ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToUint32", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Uint64ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToUint64", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Uint64ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToFloat32", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Uint64ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Uint64ToFloat64", func(t *testing.T) {
+		for _, v := range samplesUint64 {
+			if got, want := Uint64ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Uint64ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Uint64ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Uint64ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToBool", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Float32ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToString", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToString(v), many.To[string](v); got != want {
+				t.Errorf("Float32ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToInt", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Float32ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToInt8", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Float32ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToInt16", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Float32ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToInt32", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Float32ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToInt64", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Float32ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToUint", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Float32ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToUint8", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Float32ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToUint16", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Float32ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToUint32", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Float32ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToUint64", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Float32ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToFloat32", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Float32ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float32ToFloat64", func(t *testing.T) {
+		for _, v := range samplesFloat32 {
+			if got, want := Float32ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Float32ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float32ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float32ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToBool", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToBool(v), many.To[bool](v); got != want {
+				t.Errorf("Float64ToBool(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToBoolE(v)
+			want, wantErr := many.ToE[bool](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToBoolE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToString", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToString(v), many.To[string](v); got != want {
+				t.Errorf("Float64ToString(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToStringE(v)
+			want, wantErr := many.ToE[string](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToStringE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToInt", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToInt(v), many.To[int](v); got != want {
+				t.Errorf("Float64ToInt(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToIntE(v)
+			want, wantErr := many.ToE[int](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToIntE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToInt8", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToInt8(v), many.To[int8](v); got != want {
+				t.Errorf("Float64ToInt8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToInt8E(v)
+			want, wantErr := many.ToE[int8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToInt8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToInt16", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToInt16(v), many.To[int16](v); got != want {
+				t.Errorf("Float64ToInt16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToInt16E(v)
+			want, wantErr := many.ToE[int16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToInt16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToInt32", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToInt32(v), many.To[int32](v); got != want {
+				t.Errorf("Float64ToInt32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToInt32E(v)
+			want, wantErr := many.ToE[int32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToInt32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToInt64", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToInt64(v), many.To[int64](v); got != want {
+				t.Errorf("Float64ToInt64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToInt64E(v)
+			want, wantErr := many.ToE[int64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToInt64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToUint", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToUint(v), many.To[uint](v); got != want {
+				t.Errorf("Float64ToUint(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToUintE(v)
+			want, wantErr := many.ToE[uint](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToUintE(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToUint8", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToUint8(v), many.To[uint8](v); got != want {
+				t.Errorf("Float64ToUint8(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToUint8E(v)
+			want, wantErr := many.ToE[uint8](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToUint8E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToUint16", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToUint16(v), many.To[uint16](v); got != want {
+				t.Errorf("Float64ToUint16(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToUint16E(v)
+			want, wantErr := many.ToE[uint16](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToUint16E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToUint32", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToUint32(v), many.To[uint32](v); got != want {
+				t.Errorf("Float64ToUint32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToUint32E(v)
+			want, wantErr := many.ToE[uint32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToUint32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToUint64", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToUint64(v), many.To[uint64](v); got != want {
+				t.Errorf("Float64ToUint64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToUint64E(v)
+			want, wantErr := many.ToE[uint64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToUint64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToFloat32", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToFloat32(v), many.To[float32](v); got != want {
+				t.Errorf("Float64ToFloat32(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToFloat32E(v)
+			want, wantErr := many.ToE[float32](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToFloat32E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+
+	t.Run("Float64ToFloat64", func(t *testing.T) {
+		for _, v := range samplesFloat64 {
+			if got, want := Float64ToFloat64(v), many.To[float64](v); got != want {
+				t.Errorf("Float64ToFloat64(%v) = %v, many.To = %v", v, got, want)
+			}
+			got, gotErr := Float64ToFloat64E(v)
+			want, wantErr := many.ToE[float64](v)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Float64ToFloat64E(%v) = %v, %v; many.ToE = %v, %v", v, got, gotErr, want, wantErr)
+			}
+		}
+	})
+}
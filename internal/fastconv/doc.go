@@ -0,0 +1,5 @@
+// Package fastconv 是 cmd/manygen 生成的基础类型专用转换函数,
+// 覆盖全部 源类型 × 目标类型 组合, 生成的一致性测试保证其结果与 many.To / many.ToE 相同
+package fastconv
+
+//go:generate go run ../../cmd/manygen -pkg fastconv -sources bool,string,int,int8,int16,int32,int64,uint,uint8,uint16,uint32,uint64,float32,float64 -targets bool,string,int,int8,int16,int32,int64,uint,uint8,uint16,uint32,uint64,float32,float64 -o conv_gen.go -test
@@ -0,0 +1,60 @@
+package many
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lwmacct/250300-go-mod-many/internal/conformance"
+)
+
+var update = flag.Bool("update", false, "重新生成 testdata/divergences.golden")
+
+// TestConformance v10 与黄金表的差异记录在 testdata/divergences.golden 中,
+// 差异发生变化时测试失败, 确认无误后用 go test -update 更新
+func TestConformance(t *testing.T) {
+	divs := conformance.Check(conformance.Impl{
+		Name: "pkg/demo/v10",
+		Targets: map[string]conformance.Func{
+			"bool":    conformance.NewFunc(To[bool], nil),
+			"string":  conformance.NewFunc(To[string], nil),
+			"int":     conformance.NewFunc(To[int], nil),
+			"int8":    conformance.NewFunc(To[int8], nil),
+			"int16":   conformance.NewFunc(To[int16], nil),
+			"int32":   conformance.NewFunc(To[int32], nil),
+			"int64":   conformance.NewFunc(To[int64], nil),
+			"uint":    conformance.NewFunc(To[uint], nil),
+			"uint8":   conformance.NewFunc(To[uint8], nil),
+			"uint16":  conformance.NewFunc(To[uint16], nil),
+			"uint32":  conformance.NewFunc(To[uint32], nil),
+			"uint64":  conformance.NewFunc(To[uint64], nil),
+			"float32": conformance.NewFunc(To[float32], nil),
+			"float64": conformance.NewFunc(To[float64], nil),
+		},
+		// v10 直接用 int64(f) / uint64(f) 转换浮点数, 越界时结果由平台决定, 不写入黄金文件
+		Skip: map[string]string{
+			"string/int64_max":  "int64(float64(MaxInt64)) is implementation-defined",
+			"string/uint64_max": "int64(float64(MaxUint64)) is implementation-defined",
+			"string/float_huge": "int64(1e20) is implementation-defined",
+			"string/float_inf":  "int64(+Inf) is implementation-defined",
+			"int64/float_huge":  "int64(1e300) is implementation-defined",
+			"uint64/float_huge": "uint64(1e300) is implementation-defined",
+		},
+	})
+	report := conformance.Report(divs)
+
+	golden := filepath.Join("testdata", "divergences.golden")
+	if *update {
+		if err := os.WriteFile(golden, []byte(report), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report != string(want) {
+		t.Errorf("差异报告与 %s 不一致, 确认后使用 -update 更新\n得到:\n%s\n期望:\n%s", golden, report, want)
+	}
+}
@@ -0,0 +1,180 @@
+package many
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// batchConfig 批量转换的配置
+type batchConfig struct {
+	workers   int
+	chunkSize int
+}
+
+// BatchOption 批量转换选项
+type BatchOption func(*batchConfig)
+
+// defaultChunkSize 并行转换时每个分块的默认大小
+const defaultChunkSize = 64 * 1024
+
+// WithParallel 将输入分块后由 workers 个 goroutine 并行转换, workers <= 0 时使用 GOMAXPROCS
+// 输入不足两个分块时仍然顺序执行
+func WithParallel(workers int) BatchOption {
+	return func(c *batchConfig) {
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+		c.workers = workers
+	}
+}
+
+// WithChunkSize 设置并行转换时每个分块的元素个数
+func WithChunkSize(n int) BatchOption {
+	return func(c *batchConfig) {
+		if n > 0 {
+			c.chunkSize = n
+		}
+	}
+}
+
+// ConvertSlice 按 ToE 的规则逐个转换 src 中的元素
+// errs 在全部成功时为 nil, 否则与 src 等长, 失败位置为对应的错误, 可用 BatchErrors 汇总
+func ConvertSlice[T any](src []any, opts ...BatchOption) ([]T, []error) {
+	return ConvertColumn[T](src, opts...)
+}
+
+// ConvertColumn 与 ConvertSlice 相同, 但接受任意元素类型的切片
+// []string 转基础类型时直接解析字符串, 不对每个元素装箱
+func ConvertColumn[T, S any](src []S, opts ...BatchOption) ([]T, []error) {
+	cfg := batchConfig{workers: 1, chunkSize: defaultChunkSize}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	d := dispatcherOf[T]()
+	convert := columnFunc[T, S](d)
+
+	dst := make([]T, len(src))
+	errs := &batchErrs{n: len(src)}
+
+	if cfg.workers <= 1 || len(src) < 2*cfg.chunkSize {
+		convert(dst, src, 0, errs)
+		return dst, errs.errs
+	}
+
+	var wg sync.WaitGroup
+	chunks := make(chan int)
+	for range cfg.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range chunks {
+				end := min(start+cfg.chunkSize, len(src))
+				convert(dst[start:end], src[start:end], start, errs)
+			}
+		}()
+	}
+	for start := 0; start < len(src); start += cfg.chunkSize {
+		chunks <- start
+	}
+	close(chunks)
+	wg.Wait()
+
+	return dst, errs.errs
+}
+
+// columnFunc 返回转换一段切片的函数, offset 为该段在原切片中的起始下标
+func columnFunc[T, S any](d *dispatcher[T]) func(dst []T, src []S, offset int, errs *batchErrs) {
+	if fromString := d.fromStringE; fromString != nil {
+		if _, ok := any((*S)(nil)).(*string); ok {
+			return func(dst []T, src []S, offset int, errs *batchErrs) {
+				for i, s := range any(src).([]string) {
+					v, err := fromString(s)
+					if err != nil {
+						errs.set(offset+i, err)
+					}
+					dst[i] = v
+				}
+			}
+		}
+	}
+
+	return func(dst []T, src []S, offset int, errs *batchErrs) {
+		for i := range src {
+			v, err := d.convertE(any(src[i]))
+			if err != nil {
+				errs.set(offset+i, err)
+			}
+			dst[i] = v
+		}
+	}
+}
+
+// batchErrs 在首次出现错误时才分配错误切片, 可被多个 goroutine 同时写入
+type batchErrs struct {
+	mu   sync.Mutex
+	n    int
+	errs []error
+}
+
+func (b *batchErrs) set(i int, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.errs == nil {
+		b.errs = make([]error, b.n)
+	}
+	b.errs[i] = err
+}
+
+// BatchError 汇总批量转换中失败的元素
+type BatchError struct {
+	Total   int     // 输入元素总数
+	Indices []int   // 失败元素的下标, 升序
+	Errs    []error // 与 Indices 一一对应的错误
+}
+
+// maxListedIndices Error() 中最多列出的下标个数
+const maxListedIndices = 10
+
+// Error 返回失败数量以及前若干个失败下标
+func (e *BatchError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d of %d values failed to convert at indices [", len(e.Indices), e.Total)
+	for i, idx := range e.Indices {
+		if i == maxListedIndices {
+			b.WriteString(" ...")
+			break
+		}
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(formatInt(int64(idx)))
+	}
+	b.WriteString("]")
+	if len(e.Errs) > 0 {
+		fmt.Fprintf(&b, ": first error: %v", e.Errs[0])
+	}
+	return b.String()
+}
+
+// Unwrap 支持 errors.Is / errors.As 检查其中的各个错误
+func (e *BatchError) Unwrap() []error {
+	return e.Errs
+}
+
+// BatchErrors 将 ConvertSlice/ConvertColumn 返回的 errs 汇总为 *BatchError, 全部成功时返回 nil
+func BatchErrors(errs []error) error {
+	e := &BatchError{Total: len(errs)}
+	for i, err := range errs {
+		if err != nil {
+			e.Indices = append(e.Indices, i)
+			e.Errs = append(e.Errs, err)
+		}
+	}
+	if len(e.Indices) == 0 {
+		return nil
+	}
+	return e
+}
@@ -0,0 +1,149 @@
+package many
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestConvertSlice(t *testing.T) {
+	src := []any{"1", 2, 3.9, "abc", nil, true}
+	got, errs := ConvertSlice[int](src)
+
+	want := []int{1, 2, 3, 0, 0, 1}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got[%d] = %d, 期望 %d", i, got[i], want[i])
+		}
+	}
+	if len(errs) != len(src) {
+		t.Fatalf("len(errs) = %d, 期望 %d", len(errs), len(src))
+	}
+	for i, err := range errs {
+		if (err != nil) != (i == 3) {
+			t.Errorf("errs[%d] = %v", i, err)
+		}
+	}
+
+	if _, errs := ConvertSlice[string]([]any{"a", 1}); errs != nil {
+		t.Errorf("全部成功时 errs 应为 nil, 得到 %v", errs)
+	}
+}
+
+func TestConvertColumnStrings(t *testing.T) {
+	src := []string{"1", "-2", "3.5", "x", "1e3"}
+
+	ints, errs := ConvertColumn[int64](src)
+	if want := []int64{1, -2, 3, 0, 1000}; !equalSlices(ints, want) {
+		t.Errorf("ConvertColumn[int64] = %v, 期望 %v", ints, want)
+	}
+	if err := BatchErrors(errs); err == nil || !strings.Contains(err.Error(), "1 of 5 values failed to convert at indices [3]") {
+		t.Errorf("BatchErrors() = %v", err)
+	}
+
+	// 字符串快速路径与 ToE 的结果保持一致
+	for _, s := range src {
+		for i, conv := range []func(string) (any, error){
+			func(s string) (any, error) { v, e := ConvertColumn[uint8]([]string{s}); return v[0], errAt(e) },
+			func(s string) (any, error) { v, e := ConvertColumn[float32]([]string{s}); return v[0], errAt(e) },
+			func(s string) (any, error) { v, e := ConvertColumn[bool]([]string{s}); return v[0], errAt(e) },
+		} {
+			got, gotErr := conv(s)
+			var want any
+			var wantErr error
+			switch i {
+			case 0:
+				want, wantErr = ToE[uint8](s)
+			case 1:
+				want, wantErr = ToE[float32](s)
+			case 2:
+				want, wantErr = ToE[bool](s)
+			}
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("ConvertColumn(%q) = %v, %v; ToE = %v, %v", s, got, gotErr, want, wantErr)
+			}
+		}
+	}
+}
+
+func TestConvertColumnParallel(t *testing.T) {
+	src := make([]string, 10000)
+	for i := range src {
+		src[i] = strconv.Itoa(i)
+	}
+	src[4321] = "bad"
+	src[9999] = "bad"
+
+	got, errs := ConvertColumn[int](src, WithParallel(4), WithChunkSize(100))
+	for i, v := range got {
+		want := i
+		if i == 4321 || i == 9999 {
+			want = 0
+		}
+		if v != want {
+			t.Fatalf("got[%d] = %d, 期望 %d", i, v, want)
+		}
+	}
+
+	var be *BatchError
+	if err := BatchErrors(errs); !errors.As(err, &be) {
+		t.Fatalf("BatchErrors() = %v, 期望 *BatchError", err)
+	}
+	// 0 转 int 按 ToE 的规则也视为失败
+	if want := []int{0, 4321, 9999}; !equalSlices(be.Indices, want) {
+		t.Errorf("Indices = %v, 期望 %v", be.Indices, want)
+	}
+}
+
+func TestBatchErrorTruncates(t *testing.T) {
+	errs := make([]error, 20)
+	for i := range errs {
+		errs[i] = errors.New("bad")
+	}
+	msg := BatchErrors(errs).Error()
+	if !strings.Contains(msg, "[0 1 2 3 4 5 6 7 8 9 ...]") {
+		t.Errorf("Error() = %q, 期望最多列出 10 个下标", msg)
+	}
+}
+
+func errAt(errs []error) error {
+	if errs == nil {
+		return nil
+	}
+	return errs[0]
+}
+
+func equalSlices[E comparable](a, b []E) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkConvertColumnStrings(b *testing.B) {
+	src := make([]string, 100000)
+	for i := range src {
+		src[i] = strconv.Itoa(i + 1)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = ConvertColumn[int64](src)
+	}
+}
+
+func BenchmarkConvertColumnStringsParallel(b *testing.B) {
+	src := make([]string, 100000)
+	for i := range src {
+		src[i] = strconv.Itoa(i + 1)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = ConvertColumn[int64](src, WithParallel(0), WithChunkSize(10000))
+	}
+}
@@ -0,0 +1,151 @@
+package many
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+)
+
+// BytesEncoding 字符串转换为 []byte 时使用的解码方式
+type BytesEncoding int
+
+const (
+	// BytesRaw 直接使用字符串的原始字节
+	BytesRaw BytesEncoding = iota
+	// BytesBase64 按标准 base64 解码, 同时兼容无填充的写法
+	BytesBase64
+	// BytesHex 按十六进制解码
+	BytesHex
+)
+
+// ToBytes 将 v 转换为 []byte, 字符串按 enc 指定的方式解码, 失败时返回 nil
+func ToBytes(v any, enc BytesEncoding) []byte {
+	b, _ := ToBytesE(v, enc)
+	return b
+}
+
+// ToBytesE 与 ToBytes 相同, 但会返回解码错误
+func ToBytesE(v any, enc BytesEncoding) ([]byte, error) {
+	var s string
+	switch val := v.(type) {
+	case string:
+		s = val
+	case json.Number:
+		s = string(val)
+	default:
+		return toBytes(v), nil
+	}
+
+	switch enc {
+	case BytesRaw:
+		return []byte(s), nil
+	case BytesBase64:
+		if b, err := base64.StdEncoding.DecodeString(s); err == nil {
+			return b, nil
+		}
+		b, err := base64.RawStdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %q as base64: %w", s, err)
+		}
+		return b, nil
+	case BytesHex:
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %q as hex: %w", s, err)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("unknown bytes encoding %d", enc)
+	}
+}
+
+// ToRune 将 v 转换为 rune, 与 To[rune] 不同, 单字符字符串按码点解释, 如 "A" -> 'A'
+// 其余输入 (包括多字符字符串) 按数值处理, 与 To[int32] 一致
+func ToRune(v any) rune {
+	r, _ := ToRuneE(v)
+	return r
+}
+
+// ToRuneE 与 ToRune 相同, 但在无法转换时返回错误
+func ToRuneE(v any) (rune, error) {
+	switch val := v.(type) {
+	case string:
+		if r, size := utf8.DecodeRuneInString(val); size > 0 && size == len(val) && r != utf8.RuneError {
+			return r, nil
+		}
+	case []byte:
+		if r, size := utf8.DecodeRune(val); size > 0 && size == len(val) && r != utf8.RuneError {
+			return r, nil
+		}
+		return ToE[rune](string(val))
+	}
+	return ToE[rune](v)
+}
+
+// RuneToString 是 ToRune 的反向转换, 将码点转换为对应字符, 如 'A' -> "A"
+// 与 To[string] 不同, rune 不会被格式化为数字; 无效码点返回空字符串
+func RuneToString(v any) string {
+	s, _ := RuneToStringE(v)
+	return s
+}
+
+// RuneToStringE 与 RuneToString 相同, 但在无法转换或码点无效时返回错误
+func RuneToStringE(v any) (string, error) {
+	r, err := ToRuneE(v)
+	if err != nil {
+		// ToE 把非 nil 输入得到的零值视为失败, 但整数码点 0 是合法的 NUL 字符
+		if isZeroInteger(v) {
+			return "\x00", nil
+		}
+		return "", err
+	}
+	if !utf8.ValidRune(r) {
+		return "", fmt.Errorf("invalid code point %d", r)
+	}
+	return string(r), nil
+}
+
+// isZeroInteger 判断 v 是否为值为 0 的整数类型 (含 rune)
+func isZeroInteger(v any) bool {
+	rv := reflect.ValueOf(v)
+	return rv.CanInt() && rv.Int() == 0 || rv.CanUint() && rv.Uint() == 0
+}
+
+// toBytes 将各种类型转换为[]byte
+func toBytes(v any) []byte {
+	if v == nil {
+		return nil
+	}
+
+	switch val := v.(type) {
+	case []byte:
+		return val
+	case string:
+		return []byte(val)
+	case []rune:
+		return []byte(string(val))
+	default:
+		return []byte(toString(val))
+	}
+}
+
+// toRunes 将各种类型转换为[]rune
+func toRunes(v any) []rune {
+	if v == nil {
+		return nil
+	}
+
+	switch val := v.(type) {
+	case []rune:
+		return val
+	case string:
+		return []rune(val)
+	case []byte:
+		return []rune(string(val))
+	default:
+		return []rune(toString(val))
+	}
+}
@@ -0,0 +1,133 @@
+package many
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestToBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		enc      BytesEncoding
+		expected []byte
+		wantErr  bool
+	}{
+		{"原始字符串", "hi", BytesRaw, []byte("hi"), false},
+		{"base64", "aGk=", BytesBase64, []byte("hi"), false},
+		{"base64无填充", "aGk", BytesBase64, []byte("hi"), false},
+		{"base64无效", "!!", BytesBase64, nil, true},
+		{"hex", "6869", BytesHex, []byte("hi"), false},
+		{"hex无效", "zz", BytesHex, nil, true},
+		{"[]byte原样返回", []byte{1, 2}, BytesHex, []byte{1, 2}, false},
+		{"整数", 42, BytesRaw, []byte("42"), false},
+		{"[]rune", []rune("你好"), BytesRaw, []byte("你好"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToBytesE(tt.input, tt.enc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToBytesE(%v) 错误 = %v, 期望错误 = %v", tt.input, err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.expected) {
+				t.Errorf("ToBytesE(%v) = %v, 期望 %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	if got := To[[]byte]("abc"); !bytes.Equal(got, []byte("abc")) {
+		t.Errorf("To[[]byte](\"abc\") = %v, 期望 %v", got, []byte("abc"))
+	}
+}
+
+func TestBytesToString(t *testing.T) {
+	// []byte 转字符串应得到文本, 而不是 JSON 序列化后的 base64
+	if got := To[string]([]byte("hello")); got != "hello" {
+		t.Errorf("To[string]([]byte) = %q, 期望 %q", got, "hello")
+	}
+	if got := To[string]([]rune("你好")); got != "你好" {
+		t.Errorf("To[string]([]rune) = %q, 期望 %q", got, "你好")
+	}
+}
+
+func TestRuneToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected string
+		wantErr  bool
+	}{
+		{"rune", 'A', "A", false},
+		{"中文rune", '你', "你", false},
+		{"整数码点", 97, "a", false},
+		{"NUL码点", rune(0), "\x00", false},
+		{"整数零码点", 0, "\x00", false},
+		{"无符号零码点", uint8(0), "\x00", false},
+		{"单字符字符串保持不变", "7", "7", false},
+		{"数字字符串按码点", "65", "A", false},
+		{"负数码点", -1, "", true},
+		{"代理区码点", 0xD800, "", true},
+		{"无效", "abc", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RuneToStringE(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RuneToStringE(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("RuneToStringE(%v) = %q, 期望 %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	// 双向往返
+	for _, s := range []string{"A", "你", "😀", "7"} {
+		if got := RuneToString(ToRune(s)); got != s {
+			t.Errorf("RuneToString(ToRune(%q)) = %q", s, got)
+		}
+	}
+	for _, r := range []rune{'A', '你', '😀', 0} {
+		s, err := RuneToStringE(r)
+		if err != nil || s != string(r) {
+			t.Fatalf("RuneToStringE(%q) = %q, %v, 期望 %q", r, s, err, string(r))
+		}
+		if got := ToRune(s); got != r {
+			t.Errorf("ToRune(%q) = %q, 期望 %q", s, got, r)
+		}
+	}
+}
+
+func TestToRune(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected rune
+	}{
+		{"单字符", "A", 'A'},
+		{"中文单字符", "你", '你'},
+		{"单个数字字符按码点", "7", '7'},
+		{"多字符数字", "65", 65},
+		{"[]byte单字符", []byte("B"), 'B'},
+		{"整数", 97, 'a'},
+		{"无效", "abc", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToRune(tt.input); got != tt.expected {
+				t.Errorf("ToRune(%v) = %q, 期望 %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	// To[rune] 保持数值语义
+	if got := To[rune]("A"); got != 0 {
+		t.Errorf("To[rune](\"A\") = %d, 期望 0", got)
+	}
+	if got := To[[]rune]("你好"); string(got) != "你好" {
+		t.Errorf("To[[]rune](\"你好\") = %q, 期望 %q", string(got), "你好")
+	}
+}
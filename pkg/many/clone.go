@@ -0,0 +1,274 @@
+package many
+
+import (
+	"encoding/json"
+	"reflect"
+	"unsafe"
+)
+
+// cloneConfig Clone 的配置
+type cloneConfig struct {
+	unexported bool
+}
+
+// CloneOption Clone 的选项
+type CloneOption func(*cloneConfig)
+
+// WithUnexported 同时深复制结构体的未导出字段, 默认只浅复制
+// 未导出字段中的指针会被复制为新对象, 如 time.Time 中的 *time.Location, 复制后不再与 time.UTC 等指针相等
+func WithUnexported() CloneOption {
+	return func(c *cloneConfig) {
+		c.unexported = true
+	}
+}
+
+// Clone 返回 v 的深拷贝, 常用于在 goroutine 之间传递解码得到的 map[string]any
+//
+//   - map, 切片, 数组, 指针与接口中的值递归复制, map 的键不复制
+//   - 同一个指针, map 或切片出现多次时只复制一次, 环形结构保持原有形状
+//   - 结构体的导出字段递归复制, 未导出字段默认浅复制, 见 WithUnexported
+//   - 通道, 函数等无法复制的值保持原样
+//   - v 或嵌套的值实现了 Clone() 方法且返回值类型与自身相同时, 调用该方法;
+//     Clone 方法中需要默认的深复制时使用 CloneDefault, 对自身调用 many.Clone 会无限递归
+func Clone[T any](v T, opts ...CloneOption) T {
+	return cloneRoot(newCloner(opts), v, true)
+}
+
+// CloneDefault 与 Clone 相同, 但不调用 v 自身的 Clone 方法, v 为指针或接口时也不调用其指向的值的方法,
+// 嵌套的值仍调用各自的 Clone 方法; 用于在 Clone 方法中实现默认行为:
+//
+//	func (c *Config) Clone() *Config {
+//		dst := many.CloneDefault(c)
+//		dst.conn = nil
+//		return dst
+//	}
+func CloneDefault[T any](v T, opts ...CloneOption) T {
+	return cloneRoot(newCloner(opts), v, false)
+}
+
+func newCloner(opts []CloneOption) *cloner {
+	c := &cloner{seen: make(map[cloneKey]reflect.Value)}
+	for _, opt := range opts {
+		opt(&c.cfg)
+	}
+	return c
+}
+
+// cloneRoot 复制根值, useMethod 表示是否调用根值的 Clone 方法, T 为接口类型时根值是接口中的值
+func cloneRoot[T any](c *cloner, v T, useMethod bool) T {
+	switch any(v).(type) {
+	case map[string]any, []any:
+		return c.cloneAny(v).(T)
+	}
+
+	var dst T
+	d, src := reflect.ValueOf(&dst).Elem(), reflect.ValueOf(&v).Elem()
+	if src.Kind() == reflect.Interface && !src.IsNil() {
+		elem := reflect.New(src.Elem().Type()).Elem()
+		c.copy(elem, src.Elem(), useMethod)
+		d.Set(elem)
+		return dst
+	}
+	c.copy(d, src, useMethod)
+	return dst
+}
+
+// cloneKey 标识已复制的引用, 切片还需要长度区分同一底层数组的不同切片
+type cloneKey struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+type cloner struct {
+	cfg  cloneConfig
+	seen map[cloneKey]reflect.Value
+}
+
+// copy 将 src 的深拷贝写入 dst, dst 可寻址且为零值, useMethod 表示是否调用 src 的 Clone 方法,
+// 为 false 时同样不调用 src 指向的值的方法
+func (c *cloner) copy(dst, src reflect.Value, useMethod bool) {
+	if useMethod && c.callClone(dst, src) {
+		return
+	}
+	if (src.Type() == mapAnyType || src.Type() == sliceAnyType) && src.CanInterface() {
+		if !src.IsNil() {
+			dst.Set(reflect.ValueOf(c.cloneAny(src.Interface())))
+		}
+		return
+	}
+
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		key := cloneKey{ptr: src.Pointer(), typ: src.Type()}
+		if p, ok := c.seen[key]; ok {
+			dst.Set(p)
+			return
+		}
+		p := reflect.New(src.Type().Elem())
+		c.seen[key] = p
+		c.copy(p.Elem(), src.Elem(), useMethod)
+		dst.Set(p)
+
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		key := cloneKey{ptr: src.Pointer(), typ: src.Type()}
+		if m, ok := c.seen[key]; ok {
+			dst.Set(m)
+			return
+		}
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		c.seen[key] = m
+		elemType := src.Type().Elem()
+		iter := src.MapRange()
+		for iter.Next() {
+			elem := reflect.New(elemType).Elem()
+			c.copy(elem, iter.Value(), true)
+			m.SetMapIndex(iter.Key(), elem)
+		}
+		dst.Set(m)
+
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		key := cloneKey{ptr: src.Pointer(), typ: src.Type(), len: src.Len()}
+		if s, ok := c.seen[key]; ok {
+			dst.Set(s)
+			return
+		}
+		s := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		c.seen[key] = s
+		for i := range src.Len() {
+			c.copy(s.Index(i), src.Index(i), true)
+		}
+		dst.Set(s)
+
+	case reflect.Array:
+		for i := range src.Len() {
+			c.copy(dst.Index(i), src.Index(i), true)
+		}
+
+	case reflect.Struct:
+		c.copyStruct(dst, src)
+
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		if src.CanInterface() {
+			dst.Set(reflect.ValueOf(c.cloneAny(src.Interface())))
+			return
+		}
+		elem := src.Elem()
+		v := reflect.New(elem.Type()).Elem()
+		c.copy(v, elem, true)
+		dst.Set(v)
+
+	default:
+		dst.Set(src)
+	}
+}
+
+// cloneAny 复制接口中的值, 解码 JSON 得到的常见类型不经过反射, v 不为 nil
+func (c *cloner) cloneAny(v any) any {
+	switch val := v.(type) {
+	case string, float64, bool, json.Number, int, int64:
+		return val
+	case map[string]any:
+		if val == nil {
+			return val
+		}
+		key := cloneKey{ptr: reflect.ValueOf(val).Pointer(), typ: mapAnyType}
+		if m, ok := c.seen[key]; ok {
+			return m.Interface()
+		}
+		m := make(map[string]any, len(val))
+		c.seen[key] = reflect.ValueOf(m)
+		for k, e := range val {
+			if e != nil {
+				e = c.cloneAny(e)
+			}
+			m[k] = e
+		}
+		return m
+	case []any:
+		if val == nil {
+			return val
+		}
+		key := cloneKey{ptr: reflect.ValueOf(val).Pointer(), typ: sliceAnyType, len: len(val)}
+		if s, ok := c.seen[key]; ok {
+			return s.Interface()
+		}
+		s := make([]any, len(val))
+		c.seen[key] = reflect.ValueOf(s)
+		for i, e := range val {
+			if e != nil {
+				e = c.cloneAny(e)
+			}
+			s[i] = e
+		}
+		return s
+	default:
+		src := reflect.ValueOf(v)
+		dst := reflect.New(src.Type()).Elem()
+		c.copy(dst, src, true)
+		return dst.Interface()
+	}
+}
+
+var (
+	mapAnyType   = reflect.TypeFor[map[string]any]()
+	sliceAnyType = reflect.TypeFor[[]any]()
+)
+
+// copyStruct 先整体浅复制, 再逐个深复制导出字段, 启用 WithUnexported 时包括未导出字段
+func (c *cloner) copyStruct(dst, src reflect.Value) {
+	dst.Set(src)
+	if c.cfg.unexported && !src.CanAddr() {
+		// 读取未导出字段的地址需要 src 可寻址
+		tmp := reflect.New(src.Type()).Elem()
+		tmp.Set(src)
+		src = tmp
+	}
+
+	t := src.Type()
+	for i := range t.NumField() {
+		df, sf := dst.Field(i), src.Field(i)
+		if !t.Field(i).IsExported() {
+			if !c.cfg.unexported {
+				continue
+			}
+			df = reflect.NewAt(df.Type(), unsafe.Pointer(df.UnsafeAddr())).Elem()
+			sf = reflect.NewAt(sf.Type(), unsafe.Pointer(sf.UnsafeAddr())).Elem()
+		}
+		// 字段已被浅复制, 清零后再写入深拷贝
+		df.SetZero()
+		c.copy(df, sf, true)
+	}
+}
+
+// callClone src 的类型具有 Clone() 方法且返回值类型与自身相同时调用该方法, nil 指针不调用
+func (c *cloner) callClone(dst, src reflect.Value) bool {
+	if !src.CanInterface() {
+		return false
+	}
+	if (src.Kind() == reflect.Pointer || src.Kind() == reflect.Interface) && src.IsNil() {
+		return false
+	}
+	m := src.MethodByName("Clone")
+	if !m.IsValid() {
+		return false
+	}
+	mt := m.Type()
+	if mt.NumIn() != 0 || mt.NumOut() != 1 || mt.Out(0) != src.Type() {
+		return false
+	}
+	dst.Set(m.Call(nil)[0])
+	return true
+}
@@ -0,0 +1,297 @@
+package many
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClone(t *testing.T) {
+	t.Run("map[string]any 树", func(t *testing.T) {
+		src := map[string]any{
+			"name": "svc",
+			"db":   map[string]any{"port": 5432, "hosts": []any{"a", "b"}},
+			"tags": []string{"x"},
+		}
+		dst := Clone(src)
+		if !reflect.DeepEqual(dst, src) {
+			t.Fatalf("Clone() = %v", dst)
+		}
+		dst["db"].(map[string]any)["port"] = 1
+		dst["db"].(map[string]any)["hosts"].([]any)[0] = "changed"
+		dst["tags"].([]string)[0] = "changed"
+		if src["db"].(map[string]any)["port"] != 5432 || src["db"].(map[string]any)["hosts"].([]any)[0] != "a" || src["tags"].([]string)[0] != "x" {
+			t.Errorf("修改副本影响了原值: %v", src)
+		}
+	})
+
+	t.Run("数组与指针", func(t *testing.T) {
+		type item struct {
+			IDs  [2][]int
+			Next *item
+		}
+		src := &item{IDs: [2][]int{{1}, {2}}, Next: &item{}}
+		dst := Clone(src)
+		if dst == src || dst.Next == src.Next || !reflect.DeepEqual(dst, src) {
+			t.Fatalf("Clone() = %+v", dst)
+		}
+		dst.IDs[0][0] = 9
+		if src.IDs[0][0] != 1 {
+			t.Error("数组中的切片没有复制")
+		}
+	})
+
+	t.Run("nil 值", func(t *testing.T) {
+		if got := Clone[map[string]int](nil); got != nil {
+			t.Errorf("Clone(nil map) = %v", got)
+		}
+		if got := Clone[[]int](nil); got != nil {
+			t.Errorf("Clone(nil slice) = %v", got)
+		}
+		if got := Clone[any](nil); got != nil {
+			t.Errorf("Clone(nil any) = %v", got)
+		}
+		if got := Clone([]int{}); got == nil || len(got) != 0 {
+			t.Errorf("Clone([]int{}) = %#v, 应保持非 nil", got)
+		}
+	})
+
+	t.Run("环与共享", func(t *testing.T) {
+		type node struct {
+			V    int
+			Next *node
+		}
+		a := &node{V: 1}
+		a.Next = &node{V: 2, Next: a}
+		dst := Clone(a)
+		if dst == a || dst.Next.Next != dst {
+			t.Errorf("环形结构没有保持: %+v", dst)
+		}
+
+		shared := []int{1}
+		pair := [2][]int{shared, shared}
+		got := Clone(pair)
+		got[0][0] = 5
+		if got[1][0] != 5 || shared[0] != 1 {
+			t.Errorf("共享的切片应只复制一次: %v, %v", got, shared)
+		}
+
+		m := map[string]any{}
+		m["self"] = m
+		cm := Clone(m)
+		if reflect.ValueOf(cm["self"]).Pointer() != reflect.ValueOf(cm).Pointer() {
+			t.Error("map 的环没有保持")
+		}
+	})
+
+	t.Run("time.Time 与通道", func(t *testing.T) {
+		type event struct {
+			At time.Time
+			Ch chan int
+			Fn func()
+		}
+		ch := make(chan int)
+		src := event{At: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Ch: ch}
+		dst := Clone(src)
+		if !dst.At.Equal(src.At) || dst.At.Location() != time.UTC || dst.Ch != ch {
+			t.Errorf("Clone() = %+v", dst)
+		}
+	})
+}
+
+type cloneCounter struct {
+	N     int
+	calls *int
+}
+
+func (c cloneCounter) Clone() cloneCounter {
+	*c.calls++
+	return cloneCounter{N: c.N + 100, calls: c.calls}
+}
+
+// selfCloner 在 Clone 方法中使用 CloneDefault 实现默认行为
+type selfCloner struct {
+	Items []int
+	calls *int
+}
+
+func (s *selfCloner) Clone() *selfCloner {
+	if s.calls != nil {
+		*s.calls++
+	}
+	return CloneDefault(s)
+}
+
+// valueSelfCloner 值接收者, 经过指针复制时同样不调用自身的方法
+type valueSelfCloner struct {
+	Items []int
+}
+
+func (v valueSelfCloner) Clone() valueSelfCloner {
+	return CloneDefault(v)
+}
+
+// genericCloner 泛型类型的 Clone 方法
+type genericCloner[T any] struct {
+	Items []T
+}
+
+func (g *genericCloner[T]) Clone() *genericCloner[T] {
+	return CloneDefault(g)
+}
+
+// cloneNode 递归的 Clone 方法, 每一层都通过 many.Clone 复制子节点
+type cloneNode struct {
+	Name  string
+	Child *cloneNode
+	calls *int
+}
+
+func (n *cloneNode) Clone() *cloneNode {
+	*n.calls++
+	return &cloneNode{Name: n.Name + "'", Child: Clone(n.Child), calls: n.calls}
+}
+
+func TestCloneMethod(t *testing.T) {
+	calls := 0
+	src := []cloneCounter{{N: 1, calls: &calls}, {N: 2, calls: &calls}}
+	dst := Clone(src)
+	if calls != 2 || dst[0].N != 101 || dst[1].N != 102 {
+		t.Errorf("嵌套值应调用 Clone 方法: %+v, calls = %d", dst, calls)
+	}
+
+	// 根值自身的 Clone 方法同样被调用
+	if got := Clone(cloneCounter{N: 1, calls: &calls}); got.N != 101 || calls != 3 {
+		t.Errorf("Clone(根值) = %+v, calls = %d", got, calls)
+	}
+	var boxed any = cloneCounter{N: 1, calls: &calls}
+	if got := Clone(boxed).(cloneCounter); got.N != 101 || calls != 4 {
+		t.Errorf("Clone(接口中的根值) = %+v, calls = %d", got, calls)
+	}
+
+	// 在 Clone 方法中对自身调用 many.Clone 不会无限递归
+	s := &selfCloner{Items: []int{1}}
+	for name, c := range map[string]*selfCloner{
+		"方法":         s.Clone(),
+		"many.Clone": Clone(s),
+		"接口":         Clone(any(s)).(*selfCloner),
+		"嵌套":         Clone([]*selfCloner{s})[0],
+	} {
+		c.Items[0] = 2
+		if s.Items[0] != 1 || c == s {
+			t.Errorf("%s: selfCloner 没有深复制", name)
+		}
+	}
+
+	selfCalls := 0
+	s.calls = &selfCalls
+	if c := Clone(s); selfCalls != 1 || c == s {
+		t.Errorf("Clone 应调用根值的 Clone 方法一次, 调用了 %d 次", selfCalls)
+	}
+	if c := CloneDefault(s); selfCalls != 1 || c == s {
+		t.Errorf("CloneDefault 不应调用根值的 Clone 方法, 调用了 %d 次", selfCalls)
+	}
+	if c := CloneDefault([]*selfCloner{s}); selfCalls != 2 || c[0] == s {
+		t.Errorf("CloneDefault 应调用嵌套值的 Clone 方法, 调用了 %d 次", selfCalls)
+	}
+
+	v := valueSelfCloner{Items: []int{1}}
+	if c := Clone(v); &c.Items[0] == &v.Items[0] {
+		t.Error("valueSelfCloner 没有深复制")
+	}
+	if c := CloneDefault(&v); &c.Items[0] == &v.Items[0] {
+		t.Error("valueSelfCloner 指针没有深复制")
+	}
+	g := &genericCloner[int]{Items: []int{1}}
+	if c := Clone(g); &c.Items[0] == &g.Items[0] {
+		t.Error("genericCloner 没有深复制")
+	}
+}
+
+func TestCloneRecursiveMethod(t *testing.T) {
+	calls := 0
+	root := &cloneNode{Name: "a", calls: &calls}
+	root.Child = &cloneNode{Name: "b", calls: &calls}
+	root.Child.Child = &cloneNode{Name: "c", calls: &calls}
+
+	dst := Clone(root)
+	if calls != 3 {
+		t.Fatalf("每个节点的 Clone 方法都应执行, 调用了 %d 次", calls)
+	}
+	var names []string
+	for n := dst; n != nil; n = n.Child {
+		names = append(names, n.Name)
+	}
+	if got := strings.Join(names, ","); got != "a',b',c'" {
+		t.Errorf("Clone() 得到的节点 = %s", got)
+	}
+	if c := Clone(any(root)).(*cloneNode); calls != 6 || c.Child.Child.Name != "c'" {
+		t.Errorf("接口中的根值也应调用 Clone 方法, 调用了 %d 次", calls)
+	}
+}
+
+func TestCloneUnexported(t *testing.T) {
+	type secret struct {
+		Public  []int
+		private []int
+		ptr     *int
+	}
+	n := 1
+	src := secret{Public: []int{1}, private: []int{2}, ptr: &n}
+
+	shallow := Clone(src)
+	shallow.Public[0] = 9
+	shallow.private[0] = 9
+	if src.Public[0] != 1 || src.private[0] != 9 {
+		t.Errorf("默认只应深复制导出字段: %+v", src)
+	}
+
+	src.private[0] = 2
+	deep := Clone(src, WithUnexported())
+	deep.private[0] = 8
+	*deep.ptr = 8
+	if src.private[0] != 2 || n != 1 {
+		t.Errorf("WithUnexported 应深复制未导出字段: %+v, n = %d", src, n)
+	}
+
+	// map 中的结构体不可寻址, 仍能复制未导出字段
+	m := map[string]secret{"a": src}
+	cm := Clone(m, WithUnexported())
+	cm["a"].private[0] = 7
+	if src.private[0] != 2 {
+		t.Error("map 值中的未导出字段没有深复制")
+	}
+}
+
+// cloneBenchTree 模拟解码得到的 JSON 文档
+func cloneBenchTree() map[string]any {
+	var tree map[string]any
+	doc := `{"service":"api","replicas":3,"labels":{"app":"api","tier":"backend"},
+		"containers":[{"name":"app","image":"api:1.2.3","ports":[8080,8443],"env":{"LOG_LEVEL":"info","DEBUG":false}},
+		{"name":"sidecar","image":"proxy:2.0","ports":[15001],"env":{"MODE":"strict"}}],
+		"limits":{"cpu":0.5,"memory":"512Mi"}}`
+	if err := json.Unmarshal([]byte(doc), &tree); err != nil {
+		panic(err)
+	}
+	return tree
+}
+
+func BenchmarkClone(b *testing.B) {
+	tree := cloneBenchTree()
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = Clone(tree)
+	}
+}
+
+func BenchmarkCloneJSON(b *testing.B) {
+	tree := cloneBenchTree()
+	b.ReportAllocs()
+	for b.Loop() {
+		data, _ := json.Marshal(tree)
+		var out map[string]any
+		_ = json.Unmarshal(data, &out)
+	}
+}
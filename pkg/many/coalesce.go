@@ -0,0 +1,52 @@
+package many
+
+import "reflect"
+
+// Coalesce 返回 vals 中第一个非零值, 全部为零值时返回零值, 如 Coalesce(os.Getenv("PORT"), cfg.Port, "8080")
+func Coalesce[T comparable](vals ...T) T {
+	var zero T
+	for _, v := range vals {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
+
+// CoalescePtr 返回 ptrs 中第一个非 nil 的指针, 全部为 nil 时返回 nil
+func CoalescePtr[T any](ptrs ...*T) *T {
+	for _, p := range ptrs {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// CoalesceFunc 依次调用 fns, 返回第一个 ok 为 true 的结果, 之后的函数不再调用
+// 全部未命中时返回零值, 如
+//
+//	port := many.CoalesceFunc(
+//		func() (string, bool) { return os.LookupEnv("PORT") },
+//		func() (string, bool) { return cfg.Port, cfg.Port != "" },
+//	)
+func CoalesceFunc[T any](fns ...func() (T, bool)) T {
+	for _, fn := range fns {
+		if v, ok := fn(); ok {
+			return v
+		}
+	}
+	var zero T
+	return zero
+}
+
+// FirstNonZero 与 Coalesce 相同, 但使用 reflect.Value.IsZero 判断零值, 适用于切片, map, 含切片的结构体等不可比较的类型
+func FirstNonZero[T any](vals ...T) T {
+	for _, v := range vals {
+		if !reflect.ValueOf(&v).Elem().IsZero() {
+			return v
+		}
+	}
+	var zero T
+	return zero
+}
@@ -0,0 +1,71 @@
+package many
+
+import (
+	"testing"
+)
+
+func TestCoalesce(t *testing.T) {
+	tests := []struct {
+		name string
+		vals []string
+		want string
+	}{
+		{"第一个非零值", []string{"", "config", "default"}, "config"},
+		{"第一个即命中", []string{"env", "config"}, "env"},
+		{"全部为零值", []string{"", ""}, ""},
+		{"无参数", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Coalesce(tt.vals...); got != tt.want {
+				t.Errorf("Coalesce(%q) = %q, 期望 %q", tt.vals, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoalescePtr(t *testing.T) {
+	a, b := 1, 2
+	if got := CoalescePtr(nil, &a, &b); got != &a {
+		t.Errorf("CoalescePtr() = %v", got)
+	}
+	// 指向零值的指针同样命中
+	zero := 0
+	if got := CoalescePtr(nil, &zero); got != &zero {
+		t.Errorf("CoalescePtr() = %v", got)
+	}
+	if got := CoalescePtr[int](nil, nil); got != nil {
+		t.Errorf("CoalescePtr(nil, nil) = %v", got)
+	}
+}
+
+func TestCoalesceFunc(t *testing.T) {
+	calls := 0
+	miss := func() (int, bool) { calls++; return 0, false }
+	hit := func(v int) func() (int, bool) { return func() (int, bool) { calls++; return v, true } }
+
+	if got := CoalesceFunc(miss, hit(0), hit(2)); got != 0 || calls != 2 {
+		t.Errorf("CoalesceFunc() = %d, calls = %d, 期望 0, 2", got, calls)
+	}
+	if got := CoalesceFunc(miss, miss); got != 0 {
+		t.Errorf("CoalesceFunc() = %d", got)
+	}
+}
+
+func TestFirstNonZero(t *testing.T) {
+	type config struct {
+		Hosts []string
+	}
+	if got := FirstNonZero(config{}, config{Hosts: []string{"a"}}); len(got.Hosts) != 1 {
+		t.Errorf("FirstNonZero(struct) = %v", got)
+	}
+	if got := FirstNonZero([]int(nil), []int{}, []int{1}); got == nil || len(got) != 0 {
+		t.Errorf("FirstNonZero(slice) = %#v, 空切片不是零值", got)
+	}
+	if got := FirstNonZero[any](nil, 0, "x"); got != 0 {
+		t.Errorf("FirstNonZero(any) = %#v, 接口持有的 0 不是零值", got)
+	}
+	if got := FirstNonZero[map[string]int](nil, nil); got != nil {
+		t.Errorf("FirstNonZero(nil, nil) = %v", got)
+	}
+}
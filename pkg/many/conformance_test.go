@@ -0,0 +1,29 @@
+package many
+
+import (
+	"testing"
+
+	"github.com/lwmacct/250300-go-mod-many/internal/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, conformance.Impl{
+		Name: "pkg/many",
+		Targets: map[string]conformance.Func{
+			"bool":    conformance.NewFunc(To[bool], ToE[bool]),
+			"string":  conformance.NewFunc(To[string], ToE[string]),
+			"int":     conformance.NewFunc(To[int], ToE[int]),
+			"int8":    conformance.NewFunc(To[int8], ToE[int8]),
+			"int16":   conformance.NewFunc(To[int16], ToE[int16]),
+			"int32":   conformance.NewFunc(To[int32], ToE[int32]),
+			"int64":   conformance.NewFunc(To[int64], ToE[int64]),
+			"uint":    conformance.NewFunc(To[uint], ToE[uint]),
+			"uint8":   conformance.NewFunc(To[uint8], ToE[uint8]),
+			"uint16":  conformance.NewFunc(To[uint16], ToE[uint16]),
+			"uint32":  conformance.NewFunc(To[uint32], ToE[uint32]),
+			"uint64":  conformance.NewFunc(To[uint64], ToE[uint64]),
+			"float32": conformance.NewFunc(To[float32], ToE[float32]),
+			"float64": conformance.NewFunc(To[float64], ToE[float64]),
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package many
+
+// Converter 按选定的 Profile 执行转换, 创建后只读, 可在多个 goroutine 间共享
+// nil *Converter 等同于 NewConverter(), 使用 ProfileLegacy
+type Converter struct {
+	profile Profile
+	strict  bool
+}
+
+// ConverterOption Converter 的配置选项
+type ConverterOption func(*Converter)
+
+// WithProfile 选择转换行为的配置档, 默认为 ProfileLegacy
+func WithProfile(p Profile) ConverterOption {
+	return func(c *Converter) {
+		c.profile = p
+	}
+}
+
+// WithStrict 拒绝丢失信息的转换, 如带小数的浮点数转整数, 超过 2^53 的整数转 float64,
+// 结构体经 JSON 序列化转字符串, 可与任意配置档组合, ProfileStrict 总是启用
+func WithStrict() ConverterOption {
+	return func(c *Converter) {
+		c.strict = true
+	}
+}
+
+// NewConverter 创建 Converter
+func NewConverter(opts ...ConverterOption) *Converter {
+	c := &Converter{profile: ProfileLegacy}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Profile 返回 c 使用的配置档
+func (c *Converter) Profile() Profile {
+	if c == nil {
+		return ProfileLegacy
+	}
+	return c.profile
+}
+
+// Strict 报告 c 是否拒绝丢失信息的转换
+func (c *Converter) Strict() bool {
+	return c != nil && (c.strict || c.profile == ProfileStrict)
+}
+
+// ConvertTo 使用 c 的配置档将 v 转换为 T, 无法转换时返回零值
+// Go 的方法不能带类型参数, 因此以函数形式提供
+func ConvertTo[T any](c *Converter, v any) T {
+	if c.Profile() == ProfileLegacy && !c.Strict() {
+		return legacyDispatcherOf[T]().convert(v)
+	}
+	result, _ := ConvertToE[T](c, v)
+	return result
+}
+
+// ConvertToE 使用 c 的配置档将 v 转换为 T, 并返回转换错误
+func ConvertToE[T any](c *Converter, v any) (T, error) {
+	d := dispatcherOf[T]()
+	profile := c.Profile()
+	if profile == ProfileLenient {
+		if v == nil {
+			var zero T
+			return zero, nil
+		}
+		v = d.normalize(v)
+	}
+	if c.Strict() && d.loses != nil && d.loses(v) {
+		var zero T
+		return zero, lossError[T](v)
+	}
+	if profile == ProfileLegacy {
+		// 严格模式同样拒绝超出 T 范围或无法精确解释的输入, 通过后再按冻结的旧语义转换
+		if c.Strict() && v != nil {
+			if _, err := d.exactE(v); err != nil {
+				var zero T
+				return zero, err
+			}
+		}
+		return legacyDispatcherOf[T]().convertE(v)
+	}
+	return d.exactE(v)
+}
@@ -0,0 +1,295 @@
+package many
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/lwmacct/250300-go-mod-many/internal/conformance"
+)
+
+var update = flag.Bool("update", false, "重新生成 testdata/profiles.golden")
+
+func TestConverterProfiles(t *testing.T) {
+	legacy := NewConverter()
+	legacyStrict := NewConverter(WithStrict())
+	strict := NewConverter(WithProfile(ProfileStrict))
+	lenient := NewConverter(WithProfile(ProfileLenient))
+
+	tests := []struct {
+		name    string
+		c       *Converter
+		conv    func(c *Converter) (any, error)
+		want    any
+		wantErr bool
+	}{
+		{"legacy/零值即错误", legacy, convInt("0"), 0, true},
+		{"legacy/无法解析返回零值", legacy, convInt("abc"), 0, true},
+		{"legacy/nil", legacy, convInt(nil), 0, false},
+		{"nil Converter 等同 legacy", nil, convInt("0"), 0, true},
+
+		{"legacy+strict/越界", legacyStrict, convInt8(300), int8(0), true},
+		{"legacy+strict/字符串越界", legacyStrict, convUint8("300"), uint8(0), true},
+		{"legacy+strict/uint64 转 int64 越界", legacyStrict, convInt64(uint64(math.MaxUint64)), int64(0), true},
+		{"legacy+strict/范围内", legacyStrict, convInt8("-128"), int8(-128), false},
+
+		{"strict/零值不是错误", strict, convInt("0"), 0, false},
+		{"strict/整数", strict, convInt("42"), 42, false},
+		{"strict/浮点字符串带小数", strict, convInt("3.9"), 0, true},
+		{"strict/浮点字符串为整数", strict, convInt("1e3"), 1000, false},
+		{"strict/无法解析", strict, convInt("abc"), 0, true},
+		{"strict/nil", strict, convInt(nil), 0, true},
+		{"strict/越界", strict, convInt8(300), int8(0), true},
+		{"strict/负数转无符号", strict, convUint(-1), uint(0), true},
+		{"strict/不支持的类型", strict, convInt([]int{1}), 0, true},
+		{"strict/空白不去除", strict, convInt(" 1"), 0, true},
+		{"strict/拒绝数字分隔符", strict, convInt("1_000"), 0, true},
+		{"strict/拒绝小数中的分隔符", strict, convFloat64("1_000.5"), 0.0, true},
+		{"strict/bool false", strict, convBool("false"), false, false},
+		{"strict/bool 无法识别", strict, convBool("abc"), false, true},
+		{"strict/bool 数值 2", strict, convBool(2), false, true},
+		{"strict/float64 超出范围", strict, convFloat64("1e400"), 0.0, true},
+		{"strict/float32 溢出", strict, convFloat32(1e300), float32(0), true},
+		{"strict/空字符串", strict, convString(""), "", false},
+		{"strict/UUID 零值", strict, convUUID(NilUUID.String()), NilUUID, false},
+		{"strict/UUID 无法解析", strict, convUUID("x"), NilUUID, true},
+
+		{"lenient/去除空白", lenient, convInt(" 42 "), 42, false},
+		{"lenient/十六进制", lenient, convInt("0x1F"), 31, false},
+		{"lenient/二进制负数", lenient, convInt("-0b101"), -5, false},
+		{"lenient/下划线", lenient, convInt("1_000"), 1000, false},
+		{"lenient/多处下划线", lenient, convInt("1_000_000"), 1000000, false},
+		{"lenient/小数中的下划线", lenient, convFloat64("1_000.5"), 1000.5, false},
+		{"lenient/前缀后的下划线", lenient, convInt("0x_1F"), 31, false},
+		{"lenient/前导下划线", lenient, convInt("_1"), 0, true},
+		{"lenient/末尾下划线", lenient, convInt("1_"), 0, true},
+		{"lenient/连续下划线", lenient, convInt("1__0"), 0, true},
+		{"lenient/小数点旁的下划线", lenient, convFloat64("1_.5"), 0.0, true},
+		{"lenient/前缀中的下划线", lenient, convInt("0_x1F"), 0, true},
+		{"lenient/前导零按十进制", lenient, convInt("010"), 10, false},
+		{"lenient/json.Number", lenient, convInt(json.Number(" 0x10")), 16, false},
+		{"lenient/nil 为零值", lenient, convInt(nil), 0, false},
+		{"lenient/仍拒绝无法解析", lenient, convInt("abc"), 0, true},
+		{"lenient/仍拒绝越界", lenient, convInt8("0xFFF"), int8(0), true},
+		{"lenient/bool 不区分大小写", lenient, convBool(" tRuE "), true, false},
+		{"lenient/bool OFF", lenient, convBool("Off"), false, false},
+		{"lenient/bool 非零数值", lenient, convBool(2), true, false},
+		{"lenient/bool 零", lenient, convBool(0.0), false, false},
+		{"lenient/bool 无法识别", lenient, convBool("maybe"), false, true},
+		{"lenient/字符串保留空白", lenient, convString(" a "), " a ", false},
+		{"lenient/UUID 去除空白", lenient, convUUID(" " + NilUUID.String() + "\n"), NilUUID, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.conv(tt.c)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %#v, 期望 %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func convInt(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[int](c, v) }
+}
+
+func convInt8(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[int8](c, v) }
+}
+
+func convInt64(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[int64](c, v) }
+}
+
+func convUint8(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[uint8](c, v) }
+}
+
+func convUint(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[uint](c, v) }
+}
+
+func convBool(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[bool](c, v) }
+}
+
+func convFloat32(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[float32](c, v) }
+}
+
+func convFloat64(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[float64](c, v) }
+}
+
+func convString(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[string](c, v) }
+}
+
+func convUUID(v any) func(*Converter) (any, error) {
+	return func(c *Converter) (any, error) { return ConvertToE[UUID](c, v) }
+}
+
+func TestLegacyFrozen(t *testing.T) {
+	// ProfileLegacy 不随 To/ToE 的修正变化
+	c := NewConverter(WithProfile(ProfileLegacy))
+	if got, err := ConvertToE[uint8](c, 300); got != 44 || err != nil {
+		t.Errorf("ConvertToE[uint8](300) = %v, %v, 期望回绕为 44 且不报错", got, err)
+	}
+	if _, err := ToE[uint8](300); err == nil {
+		t.Error("ToE[uint8](300) 应返回越界错误")
+	}
+	if got := ConvertTo[string](c, 1.005); got != "1.00" {
+		t.Errorf("ConvertTo[string](1.005) = %q, 期望 \"1.00\"", got)
+	}
+	if got := To[string](1.005); got != "1.005" {
+		t.Errorf("To[string](1.005) = %q, 期望 \"1.005\"", got)
+	}
+	for _, v := range []any{nil, "0", "12", "abc", 3.9, true, -1, json.Number("7"), 1e300} {
+		if got, want := ConvertTo[int](c, v), To[int](v); got != want {
+			t.Errorf("ConvertTo[int](%#v) = %d, To = %d", v, got, want)
+		}
+	}
+}
+
+// profileCases 黄金表之外, 用于区分各配置档的输入
+var profileCases = []conformance.Case{
+	{Name: "int/hex", Input: "0x1F", Target: "int"},
+	{Name: "int/underscore", Input: "1_000", Target: "int"},
+	{Name: "int/space", Input: " 42 ", Target: "int"},
+	{Name: "int/frac_string", Input: "3.9", Target: "int"},
+	{Name: "uint8/overflow_string", Input: "0x1FF", Target: "uint8"},
+	{Name: "bool/mixed_case", Input: " tRuE ", Target: "bool"},
+	{Name: "bool/off", Input: "Off", Target: "bool"},
+	{Name: "bool/float_2", Input: 2.0, Target: "bool"},
+	{Name: "string/float_3_decimals", Input: 0.125, Target: "string"},
+	{Name: "float64/int_2^53+1", Input: int64(1<<53 + 1), Target: "float64"},
+	{Name: "optional_int/nil", Input: nil, Target: "Optional[int]"},
+	{Name: "optional_int/zero_string", Input: "0", Target: "Optional[int]"},
+	{Name: "optional_int/frac", Input: 3.9, Target: "Optional[int]"},
+	{Name: "optional_uint8/overflow_string", Input: "300", Target: "Optional[uint8]"},
+	{Name: "optional_string/float_3_decimals", Input: 0.125, Target: "Optional[string]"},
+}
+
+// profileTargets 目标类型名到各配置档转换结果的映射
+var profileTargets = map[string]func(*Converter, any) string{
+	"bool":    profileCell[bool],
+	"string":  profileCell[string],
+	"int":     profileCell[int],
+	"int8":    profileCell[int8],
+	"int16":   profileCell[int16],
+	"int32":   profileCell[int32],
+	"int64":   profileCell[int64],
+	"uint":    profileCell[uint],
+	"uint8":   profileCell[uint8],
+	"uint16":  profileCell[uint16],
+	"uint32":  profileCell[uint32],
+	"uint64":  profileCell[uint64],
+	"float32": profileCell[float32],
+	"float64": profileCell[float64],
+
+	"Optional[int]":    profileCell[Optional[int]],
+	"Optional[uint8]":  profileCell[Optional[uint8]],
+	"Optional[string]": profileCell[Optional[string]],
+}
+
+// profileCell 格式化 ConvertTo 的结果, ConvertToE 返回错误时追加 (err)
+// Optional 的结果写作 Some(v) 或 None
+func profileCell[T any](c *Converter, v any) string {
+	var s string
+	switch val := any(ConvertTo[T](c, v)).(type) {
+	case interface {
+		IsSome() bool
+		unwrap() any
+	}:
+		s = "None"
+		if val.IsSome() {
+			s = fmt.Sprintf("Some(%#v)", val.unwrap())
+		}
+	default:
+		s = fmt.Sprintf("%#v", val)
+	}
+	if _, err := ConvertToE[T](c, v); err != nil {
+		s += " (err)"
+	}
+	return s
+}
+
+// TestProfileGolden 各配置档的行为记录在 testdata/profiles.golden 中, 任何变化都会让测试失败,
+// ProfileLegacy 的列只能在提升主版本时改变, 其余配置档的变化需确认后用 go test -update 更新
+func TestProfileGolden(t *testing.T) {
+	profiles := []*Converter{
+		NewConverter(WithProfile(ProfileLegacy)),
+		NewConverter(WithProfile(ProfileStrict)),
+		NewConverter(WithProfile(ProfileLenient)),
+	}
+
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprint(tw, "case\ttarget\tinput")
+	for _, c := range profiles {
+		fmt.Fprintf(tw, "\t%s", c.Profile())
+	}
+	fmt.Fprintln(tw)
+	for _, tc := range append(append([]conformance.Case{}, conformance.Cases...), profileCases...) {
+		cell := profileTargets[tc.Target]
+		fmt.Fprintf(tw, "%s\t%s\t%#v", tc.Name, tc.Target, tc.Input)
+		for _, c := range profiles {
+			fmt.Fprintf(tw, "\t%s", cell(c, tc.Input))
+		}
+		fmt.Fprintln(tw)
+	}
+	tw.Flush()
+	report := b.String()
+
+	golden := filepath.Join("testdata", "profiles.golden")
+	if *update {
+		if err := os.WriteFile(golden, []byte(report), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report != string(want) {
+		t.Errorf("配置档的行为与 %s 不一致, 确认后使用 -update 更新\n得到:\n%s\n期望:\n%s", golden, report, want)
+	}
+}
+
+func TestProfileText(t *testing.T) {
+	for _, p := range []Profile{ProfileLegacy, ProfileStrict, ProfileLenient} {
+		text, err := p.MarshalText()
+		if err != nil {
+			t.Fatalf("%v.MarshalText() error = %v", p, err)
+		}
+		var got Profile
+		if err := got.UnmarshalText(text); err != nil || got != p {
+			t.Errorf("UnmarshalText(%q) = %v, %v, 期望 %v", text, got, err, p)
+		}
+	}
+
+	if p, err := ParseProfile("Strict"); err != nil || p != ProfileStrict {
+		t.Errorf(`ParseProfile("Strict") = %v, %v`, p, err)
+	}
+	if _, err := ParseProfile("v2"); err == nil {
+		t.Error(`ParseProfile("v2") 应返回错误`)
+	}
+	if s := Profile(9).String(); s != "Profile(9)" {
+		t.Errorf("Profile(9).String() = %q", s)
+	}
+
+	// 配置档可以作为转换目标, 从配置文件读取
+	if p := To[Profile]("lenient"); p != ProfileLenient {
+		t.Errorf(`To[Profile]("lenient") = %v`, p)
+	}
+}
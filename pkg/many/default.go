@@ -0,0 +1,99 @@
+package many
+
+import "reflect"
+
+// NilToValue 如果 a 为 nil, 返回 fc() 的返回值, 否则返回 a, fc 为 nil 时返回 nil
+// fc 只在 a 为 nil 时调用
+func NilToValue[T any](a *T, fc func() *T) *T {
+	if a == nil && fc != nil {
+		return fc()
+	}
+	return a
+}
+
+// NilToValueAny 是旧版 NilToValue 的兼容形式, fc 可以返回 *T 或 T, 其他类型返回 nil 而不是 panic
+//
+// Deprecated: 使用类型安全的 NilToValue
+func NilToValueAny[T any](a *T, fc func() any) *T {
+	if a != nil || fc == nil {
+		return a
+	}
+	switch val := fc().(type) {
+	case *T:
+		return val
+	case T:
+		return &val
+	default:
+		return nil
+	}
+}
+
+// NilToFirst 如果 a 为 nil, 依次调用 fcs 直到得到非 nil 的指针, 全部为 nil 时返回 nil
+func NilToFirst[T any](a *T, fcs ...func() *T) *T {
+	for _, fc := range fcs {
+		if a != nil {
+			return a
+		}
+		if fc != nil {
+			a = fc()
+		}
+	}
+	return a
+}
+
+// OrDefault 如果 v 为零值, 返回 def, 否则返回 v
+func OrDefault[T comparable](v, def T) T {
+	var zero T
+	if v == zero {
+		return def
+	}
+	return v
+}
+
+// OrDefaultFunc 与 OrDefault 相同, 但仅在 v 为零值时调用 fn
+func OrDefaultFunc[T comparable](v T, fn func() T) T {
+	var zero T
+	if v == zero {
+		return fn()
+	}
+	return v
+}
+
+// SliceOrDefault 如果 s 为空 (nil 或长度为 0), 返回 def, 否则返回 s
+func SliceOrDefault[S ~[]E, E any](s, def S) S {
+	if len(s) == 0 {
+		return def
+	}
+	return s
+}
+
+// MapOrDefault 如果 m 为空 (nil 或长度为 0), 返回 def, 否则返回 m
+func MapOrDefault[M ~map[K]V, K comparable, V any](m, def M) M {
+	if len(m) == 0 {
+		return def
+	}
+	return m
+}
+
+// NilOrDefault 如果 v 为 nil, 返回 def, 否则返回 v
+// 适用于接口, 包含 nil 指针, nil map 等的接口值同样视为 nil
+func NilOrDefault[T any](v, def T) T {
+	if IsNil(v) {
+		return def
+	}
+	return v
+}
+
+// IsNil 判断 v 是否为 nil, 包括持有 nil 指针, map, 切片, 通道, 函数的接口值
+func IsNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,136 @@
+package many
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestNilToValue(t *testing.T) {
+	def := 7
+	calls := 0
+	factory := func() *int { calls++; return &def }
+
+	if got := NilToValue(nil, factory); got != &def {
+		t.Errorf("NilToValue(nil) = %v", got)
+	}
+	v := 1
+	if got := NilToValue(&v, factory); got != &v || calls != 1 {
+		t.Errorf("NilToValue(&v) = %v, calls = %d, 非 nil 时不应调用 fc", got, calls)
+	}
+	if got := NilToValue[int](nil, nil); got != nil {
+		t.Errorf("NilToValue(nil, nil) = %v", got)
+	}
+}
+
+func TestNilToValueAny(t *testing.T) {
+	def, eight := 7, 8
+	tests := []struct {
+		name string
+		fc   func() any
+		want *int
+	}{
+		{"返回指针", func() any { return &def }, &def},
+		{"返回值", func() any { return 8 }, &eight},
+		{"返回错误类型不 panic", func() any { return "x" }, nil},
+		{"返回 nil", func() any { return nil }, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NilToValueAny[int](nil, tt.fc)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("NilToValueAny() = %v, 期望 %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNilToFirst(t *testing.T) {
+	a, b := 1, 2
+	calls := 0
+	none := func() *int { calls++; return nil }
+	some := func(p *int) func() *int { return func() *int { calls++; return p } }
+
+	if got := NilToFirst(nil, none, some(&a), some(&b)); got != &a || calls != 2 {
+		t.Errorf("NilToFirst() = %v, calls = %d", got, calls)
+	}
+	if got := NilToFirst(&b, none); got != &b {
+		t.Errorf("NilToFirst(&b) = %v", got)
+	}
+	if got := NilToFirst[int](nil, none, nil); got != nil {
+		t.Errorf("NilToFirst() = %v, 期望 nil", got)
+	}
+}
+
+func TestOrDefault(t *testing.T) {
+	if got := OrDefault("", "guest"); got != "guest" {
+		t.Errorf(`OrDefault("") = %q`, got)
+	}
+	if got := OrDefault(3, 5); got != 3 {
+		t.Errorf("OrDefault(3) = %d", got)
+	}
+	if got := OrDefaultFunc(0, func() int { return 9 }); got != 9 {
+		t.Errorf("OrDefaultFunc(0) = %d", got)
+	}
+	if got := OrDefaultFunc(1, func() int { t.Error("v 非零时不应调用 fn"); return 9 }); got != 1 {
+		t.Errorf("OrDefaultFunc(1) = %d", got)
+	}
+}
+
+func TestSliceMapOrDefault(t *testing.T) {
+	def := []string{"a"}
+	if got := SliceOrDefault(nil, def); len(got) != 1 {
+		t.Errorf("SliceOrDefault(nil) = %v", got)
+	}
+	if got := SliceOrDefault([]string{}, def); len(got) != 1 {
+		t.Errorf("SliceOrDefault([]) = %v", got)
+	}
+	if got := SliceOrDefault([]string{"x", "y"}, def); len(got) != 2 {
+		t.Errorf("SliceOrDefault() = %v", got)
+	}
+
+	type labels map[string]string
+	if got := MapOrDefault(labels(nil), labels{"k": "v"}); got["k"] != "v" {
+		t.Errorf("MapOrDefault(nil) = %v", got)
+	}
+	if got := MapOrDefault(labels{"a": "b"}, nil); got["a"] != "b" {
+		t.Errorf("MapOrDefault() = %v", got)
+	}
+}
+
+func TestNilOrDefault(t *testing.T) {
+	var f *os.File
+	var w io.Writer = f // 持有 nil 指针的接口
+	if got := NilOrDefault(w, io.Discard); got != io.Discard {
+		t.Errorf("NilOrDefault(typed nil) = %v", got)
+	}
+	if got := NilOrDefault[io.Writer](nil, io.Discard); got != io.Discard {
+		t.Errorf("NilOrDefault(nil) = %v", got)
+	}
+	errBoom := errors.New("boom")
+	if got := NilOrDefault[error](errBoom, nil); got != errBoom {
+		t.Errorf("NilOrDefault(err) = %v", got)
+	}
+
+	tests := []struct {
+		name string
+		v    any
+		want bool
+	}{
+		{"nil", nil, true},
+		{"nil 指针", (*int)(nil), true},
+		{"nil map", map[string]int(nil), true},
+		{"nil 切片", []int(nil), true},
+		{"nil 函数", (func())(nil), true},
+		{"零值整数", 0, false},
+		{"空切片", []int{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNil(tt.v); got != tt.want {
+				t.Errorf("IsNil(%#v) = %v, 期望 %v", tt.v, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package many
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// dispatcher 保存目标类型 T 的转换函数, 每个类型只构建一次
+type dispatcher[T any] struct {
+	convert  func(v any) T
+	convertE func(v any) (T, error)
+
+	// fromStringE 与 convertE 语义相同, 但直接接收字符串, 避免装箱, 仅基础类型提供
+	fromStringE func(s string) (T, error)
+
+	// exactE 供 ProfileStrict 与 ProfileLenient 使用, 仅在 v 无法表示为 T 时返回错误, 零值不视为错误
+	exactE func(v any) (T, error)
+	// normalize 供 ProfileLenient 使用, 将宽松的写法改写为 exactE 能识别的输入
+	normalize func(v any) any
+	// loses 供严格模式使用, 判断 v 转换为 T 是否丢失信息, nil 表示不会丢失
+	loses func(v any) bool
+}
+
+// 基础类型的 dispatcher 在包初始化时构建
+var (
+	boolDispatcher       = primitive(toBool, parseBool, nil, boolSource)
+	stringDispatcher     = primitive(toString, func(s string) string { return s }, nil, stringSource)
+	intDispatcher        = primitive(toInt, func(s string) int { return int(parseInt64(s)) }, intRangeInt, intSource)
+	int8Dispatcher       = primitive(func(v any) int8 { return int8(toInt64(v)) }, func(s string) int8 { return int8(parseInt64(s)) }, intRangeInt8, intSource)
+	int16Dispatcher      = primitive(func(v any) int16 { return int16(toInt64(v)) }, func(s string) int16 { return int16(parseInt64(s)) }, intRangeInt16, intSource)
+	int32Dispatcher      = primitive(func(v any) int32 { return int32(toInt64(v)) }, func(s string) int32 { return int32(parseInt64(s)) }, intRangeInt32, intSource)
+	int64Dispatcher      = primitive(toInt64, parseInt64, intRangeInt64, intSource)
+	uintDispatcher       = primitive(toUint, func(s string) uint { return uint(parseUint64(s)) }, intRangeUint, intSource)
+	uint8Dispatcher      = primitive(func(v any) uint8 { return uint8(toUint64(v)) }, func(s string) uint8 { return uint8(parseUint64(s)) }, intRangeUint8, intSource)
+	uint16Dispatcher     = primitive(func(v any) uint16 { return uint16(toUint64(v)) }, func(s string) uint16 { return uint16(parseUint64(s)) }, intRangeUint16, intSource)
+	uint32Dispatcher     = primitive(func(v any) uint32 { return uint32(toUint64(v)) }, func(s string) uint32 { return uint32(parseUint64(s)) }, intRangeUint32, intSource)
+	uint64Dispatcher     = primitive(toUint64, parseUint64, intRangeUint64, intSource)
+	float32Dispatcher    = primitive(func(v any) float32 { return float32(toFloat64(v)) }, func(s string) float32 { return float32(parseFloat64(s)) }, floatRange{32}, float32Source)
+	float64Dispatcher    = primitive(toFloat64, parseFloat64, floatRange{64}, float64Source)
+	complex64Dispatcher  = primitive(func(v any) complex64 { return complex64(toComplex128(v)) }, func(s string) complex64 { return complex64(parseComplex128(s)) }, nil, complex64Source)
+	complex128Dispatcher = primitive(toComplex128, parseComplex128, nil, complex128Source)
+	bytesDispatcher      = slice(toBytes, func(s string) []byte { return []byte(s) })
+	runesDispatcher      = slice(toRunes, func(s string) []rune { return []rune(s) })
+)
+
+// dispatchers 以 (*T)(nil) 为键缓存其他类型的 *dispatcher[T]
+var dispatchers sync.Map
+
+// dispatcherOf 返回 T 对应的 dispatcher, 基础类型通过类型选择直接取得, 不经过反射和 map 查找,
+// 其他类型首次使用时构建并缓存
+func dispatcherOf[T any]() *dispatcher[T] {
+	if d := builtinDispatcher[T](); d != nil {
+		return d
+	}
+
+	key := any((*T)(nil))
+	if d, ok := dispatchers.Load(key); ok {
+		return d.(*dispatcher[T])
+	}
+	d, _ := dispatchers.LoadOrStore(key, newDispatcher[T]())
+	return d.(*dispatcher[T])
+}
+
+// dispatcherProvider 由需要自定义转换规则的包内类型实现, 如 Optional[T], 方法不使用接收者
+// legacy 为 true 时构建冻结的 ProfileLegacy 使用的 dispatcher, 内层转换按 legacyDispatcherOf 的规则
+type dispatcherProvider interface {
+	newDispatcher(legacy bool) any
+}
+
+// newDispatcher 构建非基础类型的 dispatcher
+func newDispatcher[T any]() *dispatcher[T] {
+	if p, ok := any((*T)(nil)).(dispatcherProvider); ok {
+		return p.newDispatcher(false).(*dispatcher[T])
+	}
+	return &dispatcher[T]{
+		convert:   convertOther[T],
+		convertE:  convertOtherE[T],
+		exactE:    convertOtherExactE[T],
+		normalize: lenientText,
+	}
+}
+
+// builtinDispatcher 返回基础类型预先构建的 dispatcher, T 不是基础类型时返回 nil
+func builtinDispatcher[T any]() *dispatcher[T] {
+	var d any
+	switch any((*T)(nil)).(type) {
+	case *bool:
+		d = boolDispatcher
+	case *string:
+		d = stringDispatcher
+	case *int:
+		d = intDispatcher
+	case *int8:
+		d = int8Dispatcher
+	case *int16:
+		d = int16Dispatcher
+	case *int32:
+		d = int32Dispatcher
+	case *int64:
+		d = int64Dispatcher
+	case *uint:
+		d = uintDispatcher
+	case *uint8:
+		d = uint8Dispatcher
+	case *uint16:
+		d = uint16Dispatcher
+	case *uint32:
+		d = uint32Dispatcher
+	case *uint64:
+		d = uint64Dispatcher
+	case *float32:
+		d = float32Dispatcher
+	case *float64:
+		d = float64Dispatcher
+	case *complex64:
+		d = complex64Dispatcher
+	case *complex128:
+		d = complex128Dispatcher
+	case *[]byte:
+		d = bytesDispatcher
+	case *[]rune:
+		d = runesDispatcher
+	default:
+		return nil
+	}
+	return d.(*dispatcher[T])
+}
+
+// primitive 为可比较的基础类型构建 dispatcher, ToE 在非 nil 输入得到零值时返回错误,
+// check 不为 nil 时, 源值超出目标类型范围 (结果被截断或回绕) 也返回错误
+// parse 是 conv 对字符串输入的等价实现, src 描述 ProfileStrict 与 ProfileLenient 接受的输入
+func primitive[P comparable](conv func(any) P, parse func(string) P, check rangeCheck, src sourceKind) *dispatcher[P] {
+	return &dispatcher[P]{
+		convert: conv,
+		convertE: func(v any) (P, error) {
+			var zero P
+			result := conv(v)
+			if v != nil && result == zero {
+				return zero, fmt.Errorf("cannot convert %T to %T", v, zero)
+			}
+			if check != nil && check.overflows(v) {
+				return zero, fmt.Errorf("value %v overflows %T", v, zero)
+			}
+			return result, nil
+		},
+		fromStringE: func(s string) (P, error) {
+			var zero P
+			result := parse(s)
+			if result == zero {
+				return zero, fmt.Errorf("cannot convert string to %T", zero)
+			}
+			if check != nil && check.overflowsString(s) {
+				return zero, fmt.Errorf("value %s overflows %T", s, zero)
+			}
+			return result, nil
+		},
+		exactE: func(v any) (P, error) {
+			var zero P
+			if !src.accepts(v) {
+				return zero, fmt.Errorf("cannot convert %T to %T", v, zero)
+			}
+			if check != nil && check.overflows(v) {
+				return zero, fmt.Errorf("value %v overflows %T", v, zero)
+			}
+			return conv(v), nil
+		},
+		normalize: src.normalize,
+		loses:     src.loses,
+	}
+}
+
+// slice 为 []byte, []rune 构建 dispatcher, ToE 在非 nil 输入得到 nil 时返回错误
+func slice[E any](conv func(any) []E, parse func(string) []E) *dispatcher[[]E] {
+	convertE := func(v any) ([]E, error) {
+		result := conv(v)
+		if v != nil && result == nil {
+			return nil, fmt.Errorf("cannot convert %T to %T", v, result)
+		}
+		return result, nil
+	}
+	return &dispatcher[[]E]{
+		convert:  conv,
+		convertE: convertE,
+		fromStringE: func(s string) ([]E, error) {
+			return parse(s), nil
+		},
+		exactE:    convertE,
+		normalize: func(v any) any { return v },
+		// 其他类型经 toString 转换, 与 string 目标的规则相同
+		loses: losesString,
+	}
+}
+
+// convertOther 处理基础类型以外的目标类型
+func convertOther[T any](v any) T {
+	var zero T
+	if val, ok := v.(T); ok {
+		return val
+	}
+	if result, ok, _ := convertStd[T](v); ok {
+		return result
+	}
+	if result, ok, _ := unmarshalTo[T](v); ok {
+		return result
+	}
+	return zero
+}
+
+// convertOtherE 与 convertOther 相同, 但返回解析错误
+func convertOtherE[T any](v any) (T, error) {
+	var zero T
+
+	// 标准库类型的解析错误, 以及 UnmarshalText/UnmarshalJSON 的错误直接返回
+	if result, ok, err := convertStd[T](v); ok {
+		return result, err
+	}
+	if result, ok, err := unmarshalTo[T](v); ok {
+		return result, err
+	}
+
+	result := convertOther[T](v)
+
+	// 使用反射判断是否为零值, 接口类型的 nil 结果需单独判断, 否则 reflect.ValueOf 得到无效值
+	if v != nil && (any(result) == nil || reflect.ValueOf(result).IsZero()) {
+		return zero, fmt.Errorf("cannot convert %T to %v", v, reflect.TypeFor[T]())
+	}
+
+	return result, nil
+}
+
+// convertOtherExactE 与 convertOtherE 相同, 但结果为零值不视为错误, 仅在没有可用的转换方式时返回错误
+func convertOtherExactE[T any](v any) (T, error) {
+	var zero T
+	if val, ok := v.(T); ok {
+		return val, nil
+	}
+	if result, ok, err := convertStd[T](v); ok {
+		return result, err
+	}
+	if result, ok, err := unmarshalTo[T](v); ok {
+		return result, err
+	}
+	return zero, fmt.Errorf("cannot convert %T to %v", v, reflect.TypeFor[T]())
+}
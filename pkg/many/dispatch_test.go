@@ -0,0 +1,121 @@
+package many
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestToZeroAlloc(t *testing.T) {
+	// 预先装箱, 避免把调用方的装箱开销计入
+	var (
+		i   any = 123456
+		f   any = 123.45
+		s   any = "123456"
+		fs  any = "1.5e3"
+		b   any = true
+		u8  any = uint8(200)
+		str any = "hello"
+	)
+
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"int->int64", func() { _ = To[int64](i) }},
+		{"int->float64", func() { _ = To[float64](i) }},
+		{"float64->int", func() { _ = To[int](f) }},
+		{"string->int", func() { _ = To[int](s) }},
+		{"string->float64", func() { _ = To[float64](fs) }},
+		{"string->uint16", func() { _ = To[uint16](s) }},
+		{"bool->int8", func() { _ = To[int8](b) }},
+		{"uint8->bool", func() { _ = To[bool](u8) }},
+		{"string->string", func() { _ = To[string](str) }},
+		{"int->complex128", func() { _ = To[complex128](i) }},
+		{"ToE string->int", func() { _, _ = ToE[int](s) }},
+	}
+
+	for _, c := range cases {
+		if n := testing.AllocsPerRun(100, c.fn); n != 0 {
+			t.Errorf("%s: 每次分配 %v 次, 期望 0", c.name, n)
+		}
+	}
+}
+
+func TestDispatcherCached(t *testing.T) {
+	if dispatcherOf[int]() != dispatcherOf[int]() {
+		t.Errorf("dispatcherOf[int]() 未被缓存")
+	}
+	if any(dispatcherOf[int]()) == any(dispatcherOf[int64]()) {
+		t.Errorf("不同类型共用了 dispatcher")
+	}
+}
+
+func TestToEInterfaceTarget(t *testing.T) {
+	e := errors.New("boom")
+	id := MustParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	tests := []struct {
+		name    string
+		fn      func() (any, error)
+		want    any
+		wantErr bool
+	}{
+		{"error: 实现接口", func() (any, error) { return ToE[error](e) }, e, false},
+		{"error: 字符串无法转换", func() (any, error) { return ToE[error]("x") }, nil, true},
+		{"Stringer: 实现接口", func() (any, error) { return ToE[fmt.Stringer](id) }, id, false},
+		{"Stringer: 整数无法转换", func() (any, error) { return ToE[fmt.Stringer](1) }, nil, true},
+		{"error: nil 输入", func() (any, error) { return ToE[error](nil) }, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, 期望 %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := To[error]("x"); got != nil {
+		t.Errorf("To[error](\"x\") = %v, 期望 nil", got)
+	}
+	if _, err := ToE[error]("x"); err == nil || err.Error() != "cannot convert string to error" {
+		t.Errorf("ToE[error](\"x\") error = %v", err)
+	}
+}
+
+func BenchmarkToIntToString(b *testing.B) {
+	var v any = 42
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = To[string](v)
+	}
+}
+
+func BenchmarkToStringToInt(b *testing.B) {
+	var v any = "123456"
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = To[int](v)
+	}
+}
+
+func BenchmarkToFloatToInt64(b *testing.B) {
+	var v any = 123.45
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = To[int64](v)
+	}
+}
+
+func BenchmarkToEStringToInt(b *testing.B) {
+	var v any = "123456"
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = ToE[int](v)
+	}
+}
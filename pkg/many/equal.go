@@ -0,0 +1,428 @@
+package many
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// equalConfig LooseEqual 与 LooseDiff 的配置
+type equalConfig struct {
+	absTol float64
+	relTol float64
+}
+
+// EqualOption LooseEqual 与 LooseDiff 的选项
+type EqualOption func(*equalConfig)
+
+// WithFloatTolerance 两个数值之差的绝对值不超过 tol 时视为相等, 默认为 0
+func WithFloatTolerance(tol float64) EqualOption {
+	return func(c *equalConfig) {
+		c.absTol = tol
+	}
+}
+
+// WithRelativeTolerance 两个数值之差不超过较大者绝对值的 tol 倍时视为相等, 默认为 0
+func WithRelativeTolerance(tol float64) EqualOption {
+	return func(c *equalConfig) {
+		c.relTol = tol
+	}
+}
+
+// LooseEqual 按本包的转换规则比较 a 与 b, 如 "1", 1, 1.0, json.Number("1") 互相相等
+//
+// 标量的比较规则:
+//   - 任一方为 bool 时, 另一方须能精确转换为 bool, 如 1, "true", "yes"
+//   - 任一方为数值时, 另一方须为数值或能解析为数值的字符串, 两个整数精确比较, 其余按 float64 比较,
+//     一方为 float32 时按 float32 精度比较, NaN 与 NaN 相等
+//   - 两个字符串直接比较, 实现了 encoding.TextMarshaler 或 fmt.Stringer 的类型按其文本形式比较, 优先使用 MarshalText
+//
+// map, 切片, 数组与结构体递归比较, map 的键按 To[string] 的结果匹配, 转换后重复的键 (如 1 与 "1") 记为不相等,
+// 结构体按导出字段比较, 只有未导出字段的结构体按 reflect.DeepEqual 比较,
+// 字段名优先使用 json 标签, 因此结构体可以与解码得到的 map[string]any 比较; 指针比较其指向的值,
+// nil 切片与空切片, nil map 与空 map 相等
+func LooseEqual(a, b any, opts ...EqualOption) bool {
+	c := newLooseComparer(opts, true)
+	c.walk("$", a, b)
+	return len(c.paths) == 0
+}
+
+// LooseDiff 与 LooseEqual 使用相同的规则, 返回所有不相等的位置, 如 "$.db.port", "$.tags[1]",
+// 一方缺少的 map 键与切片元素同样列出, 结果按遍历顺序排列, map 的键按升序遍历
+func LooseDiff(a, b any, opts ...EqualOption) []string {
+	c := newLooseComparer(opts, false)
+	c.walk("$", a, b)
+	return c.paths
+}
+
+// looseComparer 递归比较两个值, 记录不相等的路径
+type looseComparer struct {
+	cfg       equalConfig
+	stopEarly bool
+	paths     []string
+	// visited 记录正在比较的指针与 map 对, 遇到环时视为相等
+	visited map[[2]uintptr]bool
+}
+
+func newLooseComparer(opts []EqualOption, stopEarly bool) *looseComparer {
+	c := &looseComparer{stopEarly: stopEarly}
+	for _, opt := range opts {
+		opt(&c.cfg)
+	}
+	return c
+}
+
+func (c *looseComparer) report(path string) {
+	c.paths = append(c.paths, path)
+}
+
+func (c *looseComparer) walk(path string, a, b any) {
+	if c.stopEarly && len(c.paths) > 0 {
+		return
+	}
+
+	ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if key, ok := refPair(ra, rb); ok {
+		if c.visited[key] {
+			return
+		}
+		if c.visited == nil {
+			c.visited = make(map[[2]uintptr]bool)
+		}
+		c.visited[key] = true
+		defer delete(c.visited, key)
+	}
+
+	ra, rb = indirect(ra), indirect(rb)
+	if !ra.IsValid() || !rb.IsValid() {
+		// nil 只与 nil, nil 切片, nil map 相等
+		if ra.IsValid() && !isNilContainer(ra) || rb.IsValid() && !isNilContainer(rb) {
+			c.report(path)
+		}
+		return
+	}
+
+	sa, okA := scalarOf(ra)
+	sb, okB := scalarOf(rb)
+	switch {
+	case okA && okB:
+		if !c.scalarEqual(sa, sb) {
+			c.report(path)
+		}
+	case okA || okB:
+		c.report(path)
+	case isList(ra) && isList(rb):
+		c.walkList(path, ra, rb)
+	case isRecord(ra) && isRecord(rb):
+		c.walkRecord(path, ra, rb)
+	default:
+		if !reflect.DeepEqual(ra.Interface(), rb.Interface()) {
+			c.report(path)
+		}
+	}
+}
+
+func (c *looseComparer) walkList(path string, a, b reflect.Value) {
+	for i := range max(a.Len(), b.Len()) {
+		elemPath := path + "[" + strconv.Itoa(i) + "]"
+		if i >= a.Len() || i >= b.Len() {
+			c.report(elemPath)
+			continue
+		}
+		c.walk(elemPath, a.Index(i).Interface(), b.Index(i).Interface())
+	}
+}
+
+func (c *looseComparer) walkRecord(path string, a, b reflect.Value) {
+	ma, collidedA, okA := recordOf(a)
+	mb, collidedB, okB := recordOf(b)
+	if !okA || !okB {
+		// 没有可比较字段的结构体按 reflect.DeepEqual 比较, 否则任意两个值都会相等
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			c.report(path)
+		}
+		return
+	}
+	keys := make([]string, 0, len(ma)+len(mb))
+	for k := range ma {
+		keys = append(keys, k)
+	}
+	for k := range mb {
+		if _, ok := ma[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		va, okA := ma[k]
+		vb, okB := mb[k]
+		if !okA || !okB || collidedA[k] || collidedB[k] {
+			c.report(path + "." + k)
+			continue
+		}
+		c.walk(path+"."+k, va, vb)
+	}
+}
+
+// refPair 两个值都是非 nil 的指针或 map 时返回其地址对, 用于检测环
+func refPair(a, b reflect.Value) ([2]uintptr, bool) {
+	if !a.IsValid() || !b.IsValid() || a.Kind() != b.Kind() {
+		return [2]uintptr{}, false
+	}
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Map:
+		if a.IsNil() || b.IsNil() {
+			return [2]uintptr{}, false
+		}
+		return [2]uintptr{a.Pointer(), b.Pointer()}, true
+	default:
+		return [2]uintptr{}, false
+	}
+}
+
+// indirect 解开指针与接口, nil 指针与 nil 接口返回无效的 reflect.Value
+// 实现了 encoding.TextMarshaler 或 fmt.Stringer 的指针保留, 由 scalarOf 处理
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		if v.Kind() == reflect.Pointer && v.CanInterface() && hasTextForm(v.Interface()) && !hasTextForm(v.Elem().Interface()) {
+			return v
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// isNilContainer 判断 v 是否为 nil 切片或 nil map
+func isNilContainer(v reflect.Value) bool {
+	return v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.IsNil()
+}
+
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+func isRecord(v reflect.Value) bool {
+	return v.Kind() == reflect.Map || v.Kind() == reflect.Struct
+}
+
+// recordOf 将 map 与结构体统一为以字符串为键的 map
+// collided 记录转换为字符串后重复的 map 键, 如 1 与 "1", 这些键无法可靠地匹配, 视为不相等;
+// 结构体只有未导出字段时 ok 为 false
+func recordOf(v reflect.Value) (record map[string]any, collided map[string]bool, ok bool) {
+	record = make(map[string]any)
+	if v.Kind() == reflect.Map {
+		iter := v.MapRange()
+		for iter.Next() {
+			k := toString(iter.Key().Interface())
+			if _, dup := record[k]; dup {
+				if collided == nil {
+					collided = make(map[string]bool)
+				}
+				collided[k] = true
+			}
+			record[k] = iter.Value().Interface()
+		}
+		return record, collided, true
+	}
+
+	t := v.Type()
+	exported := 0
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		exported++
+		name := f.Name
+		if tag, _, _ := strings.Cut(f.Tag.Get("json"), ","); tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+		record[name] = v.Field(i).Interface()
+	}
+	return record, nil, exported > 0 || t.NumField() == 0
+}
+
+// hasTextForm 判断 v 是否有文本形式
+func hasTextForm(v any) bool {
+	switch v.(type) {
+	case encoding.TextMarshaler, fmt.Stringer:
+		return true
+	default:
+		return false
+	}
+}
+
+// textForm 返回 v 的文本形式, 优先使用 MarshalText, 如 time.Time 得到 RFC 3339 格式
+func textForm(v any) (string, bool) {
+	if m, ok := v.(encoding.TextMarshaler); ok {
+		if text, err := m.MarshalText(); err == nil {
+			return string(text), true
+		}
+	}
+	if s, ok := v.(fmt.Stringer); ok {
+		return s.String(), true
+	}
+	return "", false
+}
+
+// scalarKind 标量的类别
+type scalarKind int
+
+const (
+	scalarBool scalarKind = iota
+	scalarNumber
+	scalarComplex
+	scalarString
+)
+
+// looseScalar 标量的规范形式
+type looseScalar struct {
+	kind scalarKind
+	b    bool
+	// num 为数值, isInt 时 i 保存精确的整数值
+	num     float64
+	i       *big.Int
+	isInt   bool
+	float32 bool
+	c       complex128
+	s       string
+}
+
+// scalarOf 识别标量, 先按底层类型识别基础类型 (包括自定义的 type Level int),
+// 再识别 json.Number 与具有文本形式的类型
+func scalarOf(v reflect.Value) (looseScalar, bool) {
+	if v.CanInterface() {
+		switch val := v.Interface().(type) {
+		case json.Number:
+			return stringNumber(string(val))
+		case []byte:
+			return looseScalar{kind: scalarString, s: string(val)}, true
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return looseScalar{kind: scalarBool, b: v.Bool()}, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return looseScalar{kind: scalarNumber, num: float64(v.Int()), i: big.NewInt(v.Int()), isInt: true}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return looseScalar{kind: scalarNumber, num: float64(v.Uint()), i: new(big.Int).SetUint64(v.Uint()), isInt: true}, true
+	case reflect.Float32:
+		return looseScalar{kind: scalarNumber, num: v.Float(), float32: true}, true
+	case reflect.Float64:
+		return looseScalar{kind: scalarNumber, num: v.Float()}, true
+	case reflect.Complex64, reflect.Complex128:
+		return looseScalar{kind: scalarComplex, c: v.Complex()}, true
+	case reflect.String:
+		return looseScalar{kind: scalarString, s: v.String()}, true
+	}
+
+	if v.CanInterface() {
+		if text, ok := textForm(v.Interface()); ok {
+			return looseScalar{kind: scalarString, s: text}, true
+		}
+	}
+	return looseScalar{}, false
+}
+
+// stringNumber 将字符串解析为数值标量, 整数保留精确值, 与 ProfileStrict 一样不接受数字分隔符
+func stringNumber(s string) (looseScalar, bool) {
+	if i, ok := new(big.Int).SetString(s, 10); ok {
+		f, _ := new(big.Float).SetInt(i).Float64()
+		return looseScalar{kind: scalarNumber, num: f, i: i, isInt: true}, true
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || !isNumber(s) {
+		return looseScalar{}, false
+	}
+	return looseScalar{kind: scalarNumber, num: f}, true
+}
+
+func (c *looseComparer) scalarEqual(a, b looseScalar) bool {
+	if a.kind > b.kind {
+		a, b = b, a
+	}
+	switch a.kind {
+	case scalarBool:
+		other, ok := b.asBool()
+		return ok && a.b == other
+	case scalarNumber:
+		switch b.kind {
+		case scalarNumber:
+			return c.numberEqual(a, b)
+		case scalarComplex:
+			return imag(b.c) == 0 && c.numberEqual(a, looseScalar{kind: scalarNumber, num: real(b.c)})
+		default:
+			other, ok := stringNumber(b.s)
+			return ok && c.numberEqual(a, other)
+		}
+	case scalarComplex:
+		other := b.c
+		if b.kind == scalarString {
+			parsed, err := strconv.ParseComplex(b.s, 128)
+			if err != nil || !isComplex(b.s) {
+				return false
+			}
+			other = parsed
+		}
+		return c.floatEqual(real(a.c), real(other)) && c.floatEqual(imag(a.c), imag(other))
+	default:
+		return a.s == b.s
+	}
+}
+
+// asBool 按 ProfileStrict 的规则将标量解释为 bool
+func (s looseScalar) asBool() (bool, bool) {
+	switch s.kind {
+	case scalarBool:
+		return s.b, true
+	case scalarNumber:
+		if s.num == 0 || s.num == 1 {
+			return s.num == 1, true
+		}
+	case scalarString:
+		if parseBool(s.s) {
+			return true, true
+		}
+		if parseFalse(s.s) {
+			return false, true
+		}
+	}
+	return false, false
+}
+
+func (c *looseComparer) numberEqual(a, b looseScalar) bool {
+	if a.isInt && b.isInt {
+		if a.i.Cmp(b.i) == 0 {
+			return true
+		}
+		if c.cfg.absTol == 0 && c.cfg.relTol == 0 {
+			return false
+		}
+	}
+	x, y := a.num, b.num
+	if a.float32 || b.float32 {
+		x, y = float64(float32(x)), float64(float32(y))
+	}
+	return c.floatEqual(x, y)
+}
+
+func (c *looseComparer) floatEqual(x, y float64) bool {
+	if x == y || (math.IsNaN(x) && math.IsNaN(y)) {
+		return true
+	}
+	diff := math.Abs(x - y)
+	return diff <= c.cfg.absTol || diff <= c.cfg.relTol*max(math.Abs(x), math.Abs(y))
+}
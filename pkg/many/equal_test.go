@@ -0,0 +1,168 @@
+package many
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLooseEqual(t *testing.T) {
+	type level int
+	when := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		a, b any
+		opts []EqualOption
+		want bool
+	}{
+		{"字符串与整数", "1", 1, nil, true},
+		{"整数与浮点数", 1, 1.0, nil, true},
+		{"json.Number 与字符串", json.Number("1"), "1.0", nil, true},
+		{"uint64 与 int", uint64(7), int8(7), nil, true},
+		{"大整数精确比较", uint64(math.MaxUint64), "18446744073709551615", nil, true},
+		{"大整数不等", int64(1<<53 + 1), int64(1 << 53), nil, false},
+		{"2^53+1 与浮点数", "9007199254740993", 9007199254740992.0, nil, true},
+		{"数值不等", 1, 2, nil, false},
+		{"数字分隔符不是数值", "1_000", 1000, nil, false},
+		{"复数不接受数字分隔符", "1_0+2i", 10 + 2i, nil, false},
+		{"float32 精度", float32(0.1), 0.1, nil, true},
+		{"NaN", math.NaN(), math.NaN(), nil, true},
+		{"绝对误差", 1.0, 1.05, []EqualOption{WithFloatTolerance(0.1)}, true},
+		{"超出绝对误差", 1.0, 1.2, []EqualOption{WithFloatTolerance(0.1)}, false},
+		{"相对误差", 1000.0, 1001.0, []EqualOption{WithRelativeTolerance(0.01)}, true},
+		{"整数使用误差", 100, 101, []EqualOption{WithFloatTolerance(1)}, true},
+		{"bool 与字符串", true, "yes", nil, true},
+		{"bool 与数值", false, 0, nil, true},
+		{"bool 与无法识别的字符串", true, "abc", nil, false},
+		{"bool 与 2", true, 2, nil, false},
+		{"字符串不等", "a", "b", nil, false},
+		{"字符串与无法解析的数值", "abc", 0, nil, false},
+		{"复数与实数", complex(2, 0), 2, nil, true},
+		{"复数与字符串", complex(1, 2), "1+2i", nil, true},
+		{"自定义整数类型", level(3), "3", nil, true},
+		{"[]byte 与字符串", []byte("ab"), "ab", nil, true},
+		{"TextMarshaler", when, "2024-01-02T03:04:05Z", nil, true},
+		{"Stringer", NilUUID, "00000000-0000-0000-0000-000000000000", nil, true},
+		{"指针与值", Ptr(5), "5", nil, true},
+		{"nil 与 nil 指针", nil, (*int)(nil), nil, true},
+		{"nil 与 nil 切片", nil, []int(nil), nil, true},
+		{"nil 与空切片", nil, []int{}, nil, false},
+		{"nil 与 0", nil, 0, nil, false},
+		{"nil 切片与空切片", []int(nil), []any{}, nil, true},
+		{"切片元素", []any{"1", 2.0}, []int{1, 2}, nil, true},
+		{"切片长度不同", []int{1}, []int{1, 2}, nil, false},
+		{"数组与切片", [2]int{1, 2}, []string{"1", "2"}, nil, true},
+		{"map 键转换", map[string]any{"1": "a"}, map[int]string{1: "a"}, nil, true},
+		{"map 缺少键", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, nil, false},
+		{"标量与 map", 1, map[string]int{}, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LooseEqual(tt.a, tt.b, tt.opts...); got != tt.want {
+				t.Errorf("LooseEqual(%#v, %#v) = %v, 期望 %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := LooseEqual(tt.b, tt.a, tt.opts...); got != tt.want {
+				t.Errorf("LooseEqual(%#v, %#v) = %v, 期望 %v (交换参数)", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLooseEqualStruct(t *testing.T) {
+	type db struct {
+		Host string `json:"host"`
+		Port int    `json:"port"`
+		pass string
+	}
+	type config struct {
+		Name   string   `json:"name"`
+		DB     *db      `json:"db"`
+		Tags   []string `json:"tags"`
+		Secret string   `json:"-"`
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(`{"name":"svc","db":{"host":"h","port":"5432"},"tags":["a"]}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config{Name: "svc", DB: &db{Host: "h", Port: 5432, pass: "x"}, Tags: []string{"a"}, Secret: "s"}
+	if !LooseEqual(cfg, decoded) {
+		t.Errorf("LooseEqual(struct, map) = false, diff %v", LooseDiff(cfg, decoded))
+	}
+	if !LooseEqual(&cfg, cfg) {
+		t.Error("LooseEqual(&cfg, cfg) = false")
+	}
+
+	// 只有未导出字段的结构体按 reflect.DeepEqual 比较
+	type opaque struct{ v int }
+	if LooseEqual(opaque{1}, opaque{2}) {
+		t.Error("LooseEqual(opaque{1}, opaque{2}) = true")
+	}
+	if !LooseEqual(opaque{1}, opaque{1}) {
+		t.Error("LooseEqual(opaque{1}, opaque{1}) = false")
+	}
+	if got := LooseDiff(map[string]any{"o": opaque{1}}, map[string]any{"o": opaque{2}}); !reflect.DeepEqual(got, []string{"$.o"}) {
+		t.Errorf("LooseDiff(opaque) = %v", got)
+	}
+}
+
+func TestLooseDiff(t *testing.T) {
+	a := map[string]any{
+		"name": "svc",
+		"db":   map[string]any{"host": "h", "port": 5432},
+		"tags": []any{"a", "b"},
+		"old":  true,
+	}
+	b := map[string]any{
+		"name": "svc",
+		"db":   map[string]any{"host": "h", "port": "6432"},
+		"tags": []any{"a", "c", "d"},
+		"new":  1,
+	}
+	got := LooseDiff(a, b)
+	want := []string{"$.db.port", "$.new", "$.old", "$.tags[1]", "$.tags[2]"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LooseDiff() = %v, 期望 %v", got, want)
+	}
+
+	// 键转换为字符串后重复时无法可靠匹配, 记为不相等
+	collided := map[any]any{1: "x", "1": "y", "2": "z"}
+	if got := LooseDiff(collided, map[string]any{"1": "x", "2": "z"}); !reflect.DeepEqual(got, []string{"$.1"}) {
+		t.Errorf("LooseDiff(键冲突) = %v, 期望 [$.1]", got)
+	}
+	if LooseEqual(collided, collided) {
+		t.Error("LooseEqual(键冲突) = true")
+	}
+
+	if got := LooseDiff(1, "1.0"); got != nil {
+		t.Errorf("LooseDiff(相等) = %v, 期望 nil", got)
+	}
+	if got := LooseDiff(1, 2); !reflect.DeepEqual(got, []string{"$"}) {
+		t.Errorf("LooseDiff(1, 2) = %v", got)
+	}
+}
+
+func TestLooseEqualCycle(t *testing.T) {
+	a := map[string]any{"v": 1}
+	a["self"] = a
+	b := map[string]any{"v": "1"}
+	b["self"] = b
+	if !LooseEqual(a, b) {
+		t.Error("LooseEqual(环) = false")
+	}
+
+	type node struct {
+		V    int
+		Next *node
+	}
+	x := &node{V: 1}
+	x.Next = x
+	y := &node{V: 2}
+	y.Next = y
+	if got := LooseDiff(x, y); !reflect.DeepEqual(got, []string{"$.V"}) {
+		t.Errorf("LooseDiff(环) = %v", got)
+	}
+}
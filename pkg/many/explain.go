@@ -0,0 +1,500 @@
+package many
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// StepKind 转换步骤的类别
+type StepKind string
+
+const (
+	StepSource  StepKind = "source"  // 识别输入的类型
+	StepTarget  StepKind = "target"  // 选择目标类型的转换方式
+	StepParse   StepKind = "parse"   // 字符串解析尝试
+	StepConvert StepKind = "convert" // 类型间的数值或格式转换
+	StepNarrow  StepKind = "narrow"  // 64 位中间值收窄为目标类型
+	StepPolicy  StepKind = "policy"  // 配置档与严格模式的规则
+	StepResult  StepKind = "result"  // 最终结果
+)
+
+// TraceStep 转换过程中的一步
+type TraceStep struct {
+	Kind   StepKind
+	Detail string
+}
+
+// Trace 记录一次转换经过的分支, 用于排查不符合预期的结果
+// Value 与 To (ExplainWith 时为 ConvertTo) 的结果一致, Err 与 ToE (ConvertToE) 返回的错误一致,
+// Explain 得到的 Trace 描述 To/ToE, 其 Profile 与 Strict 为零值
+type Trace struct {
+	Input   any
+	Source  string
+	Target  string
+	Profile Profile
+	Strict  bool
+	Steps   []TraceStep
+	Value   any
+	Err     error
+
+	// live 表示描述的是 To/ToE 而不是冻结的 ProfileLegacy
+	live bool
+}
+
+// Explain 按 To/ToE 的规则转换 v, 并返回转换经过的步骤
+func Explain[T any](v any) Trace {
+	return explain(newTrace[T](nil, v, true), v, To[T], ToE[T])
+}
+
+// ExplainWith 与 Explain 相同, 但使用 c 的配置档与严格模式
+func ExplainWith[T any](c *Converter, v any) Trace {
+	return explain(newTrace[T](c, v, false), v,
+		func(v any) T { return ConvertTo[T](c, v) },
+		func(v any) (T, error) { return ConvertToE[T](c, v) })
+}
+
+func newTrace[T any](c *Converter, v any, live bool) *Trace {
+	return &Trace{
+		Input:   v,
+		Source:  fmt.Sprintf("%T", v),
+		Target:  reflect.TypeFor[T]().String(),
+		Profile: c.Profile(),
+		Strict:  c.Strict(),
+		live:    live,
+	}
+}
+
+// explain 记录 convert 经过的步骤, convert 与 convertE 决定 Trace 的结果
+func explain[T any](tr *Trace, v any, convert func(any) T, convertE func(any) (T, error)) Trace {
+	if v == nil {
+		tr.add(StepSource, "nil")
+	} else {
+		tr.add(StepSource, "%s %s", tr.Source, quote(v))
+	}
+
+	d := dispatcherOf[T]()
+	input := v
+	if tr.Profile == ProfileLenient && v != nil {
+		if input = d.normalize(v); !reflect.DeepEqual(input, v) {
+			tr.add(StepPolicy, "lenient: input normalized to %T %s", input, quote(input))
+		}
+	}
+
+	if !explainRejected(tr, d, input) {
+		explainTarget[T](tr, input)
+	}
+
+	value := convert(v)
+	_, err := convertE(v)
+	tr.Value, tr.Err = value, err
+	explainPolicy(tr, value)
+	if err != nil {
+		tr.add(StepResult, "To = %s, ToE error: %v", quote(value), err)
+	} else {
+		tr.add(StepResult, "%s", quote(value))
+	}
+	return *tr
+}
+
+// String 返回多行的可读形式
+func (t Trace) String() string {
+	var b strings.Builder
+	if t.live {
+		fmt.Fprintf(&b, "%s -> %s (To/ToE", t.Source, t.Target)
+	} else {
+		fmt.Fprintf(&b, "%s -> %s (profile %s", t.Source, t.Target, t.Profile)
+	}
+	if t.Strict {
+		b.WriteString(", strict")
+	}
+	b.WriteString(")\n")
+	for _, s := range t.Steps {
+		fmt.Fprintf(&b, "  %-8s %s\n", s.Kind+":", s.Detail)
+	}
+	return b.String()
+}
+
+// frozen 报告 t 是否描述冻结的 ProfileLegacy
+func (t *Trace) frozen() bool {
+	return !t.live && t.Profile == ProfileLegacy
+}
+
+func (t *Trace) add(kind StepKind, format string, args ...any) {
+	t.Steps = append(t.Steps, TraceStep{Kind: kind, Detail: fmt.Sprintf(format, args...)})
+}
+
+// quote 字符串类输入带引号输出, 便于看出空白
+func quote(v any) string {
+	switch val := v.(type) {
+	case string:
+		return strconv.Quote(val)
+	case json.Number:
+		return strconv.Quote(string(val))
+	case []byte:
+		return strconv.Quote(string(val))
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// explainRejected 按 ConvertToE 的顺序检查严格模式的信息丢失与 exactE 的拒绝,
+// 输入在转换前被拒绝时记录原因并返回 true, 此时不再记录转换步骤
+// To/ToE 与非严格的 ProfileLegacy 总会执行转换, 不经过这些检查
+func explainRejected[T any](tr *Trace, d *dispatcher[T], input any) bool {
+	if tr.live || tr.Profile == ProfileLegacy && (!tr.Strict || input == nil) {
+		return false
+	}
+	if input == nil && tr.Profile == ProfileLenient {
+		return false
+	}
+	if tr.Strict && d.loses != nil && d.loses(input) {
+		tr.add(StepPolicy, "strict: conversion loses information")
+		return true
+	}
+	if _, err := d.exactE(input); err != nil {
+		if input == nil {
+			tr.add(StepPolicy, "strict: nil is an error")
+			return true
+		}
+		name := tr.Profile.String()
+		if tr.Profile == ProfileLegacy {
+			name = "strict"
+		}
+		tr.add(StepPolicy, "%s: input rejected: %v", name, err)
+		return true
+	}
+	return false
+}
+
+// explainTarget 按目标类型记录 To 经过的分支, 与 builtinDispatcher 的分类一致
+func explainTarget[T any](tr *Trace, v any) {
+	switch any((*T)(nil)).(type) {
+	case *int, *int8, *int16, *int32, *int64:
+		tr.add(StepTarget, "signed integer via int64")
+		i := explainInt64(tr, v)
+		explainNarrow(tr, fmt.Sprint(i), formatInt(toInt64(To[T](i))))
+	case *uint, *uint8, *uint16, *uint32, *uint64:
+		tr.add(StepTarget, "unsigned integer via uint64")
+		u := explainUint64(tr, v)
+		explainNarrow(tr, fmt.Sprint(u), formatUint(toUint64(To[T](u))))
+	case *float32:
+		tr.add(StepTarget, "float via float64")
+		f := explainFloat64(tr, v)
+		if f32 := float32(f); float64(f32) != f && !math.IsNaN(f) {
+			tr.add(StepNarrow, "float64 %v -> float32 %v", f, f32)
+		}
+	case *float64:
+		tr.add(StepTarget, "float64")
+		explainFloat64(tr, v)
+	case *complex64, *complex128:
+		tr.add(StepTarget, "complex via complex128")
+		explainComplex(tr, v)
+	case *bool:
+		tr.add(StepTarget, "bool")
+		explainBool(tr, v)
+	case *string:
+		tr.add(StepTarget, "string")
+		explainString(tr, v)
+	case *[]byte, *[]rune:
+		tr.add(StepTarget, "slice")
+		switch v.(type) {
+		case nil, []byte, []rune, string:
+			tr.add(StepConvert, "direct conversion")
+		default:
+			tr.add(StepConvert, "formatted by To[string] first")
+			explainString(tr, v)
+		}
+	default:
+		if e, ok := any((*T)(nil)).(targetExplainer); ok {
+			e.explainTarget(tr, v)
+			return
+		}
+		explainOther[T](tr, v)
+	}
+}
+
+// targetExplainer 由实现 dispatcherProvider 的包内类型实现, 按其 dispatcher 的实际分支记录步骤
+type targetExplainer interface {
+	explainTarget(tr *Trace, v any)
+}
+
+func explainInt64(tr *Trace, v any) int64 {
+	switch val := v.(type) {
+	case nil:
+		tr.add(StepConvert, "nil -> 0")
+	case string:
+		return explainParseInt(tr, val)
+	case json.Number:
+		return explainParseInt(tr, string(val))
+	case uint, uint64:
+		if u := toUint64(val); u > math.MaxInt64 {
+			tr.add(StepConvert, "%v exceeds int64, saturates to %d", val, int64(math.MaxInt64))
+		}
+	case float32, float64:
+		explainTruncate(tr, toFloat64(val), "int64")
+	case complex64, complex128:
+		if r, ok := realPart(val); ok {
+			tr.add(StepConvert, "imaginary part is 0, using real part %v", r)
+			explainTruncate(tr, r, "int64")
+		} else {
+			tr.add(StepConvert, "imaginary part is not 0 -> 0")
+		}
+	case bool:
+		tr.add(StepConvert, "bool %v -> %d", val, toInt64(val))
+	case int, int8, int16, int32, int64, uint8, uint16, uint32:
+		tr.add(StepConvert, "integer %v", val)
+	default:
+		tr.add(StepConvert, "unsupported source type %T -> 0", v)
+	}
+	return toInt64(v)
+}
+
+func explainParseInt(tr *Trace, s string) int64 {
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		tr.add(StepParse, "ParseInt(%q) = %d", s, i)
+		return i
+	}
+	tr.add(StepParse, "ParseInt(%q) failed: %v", s, unwrapNumErr(err))
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		tr.add(StepParse, "ParseFloat(%q) failed: %v -> 0", s, unwrapNumErr(err))
+		return 0
+	}
+	tr.add(StepParse, "ParseFloat(%q) = %v", s, f)
+	explainTruncate(tr, f, "int64")
+	return floatToInt64(f)
+}
+
+func explainUint64(tr *Trace, v any) uint64 {
+	switch val := v.(type) {
+	case nil:
+		tr.add(StepConvert, "nil -> 0")
+	case string:
+		return explainParseUint(tr, val)
+	case json.Number:
+		return explainParseUint(tr, string(val))
+	case int, int8, int16, int32, int64:
+		if toInt64(val) < 0 {
+			tr.add(StepConvert, "negative %v clamps to 0", val)
+		} else {
+			tr.add(StepConvert, "integer %v", val)
+		}
+	case float32, float64:
+		explainTruncate(tr, toFloat64(val), "uint64")
+	case complex64, complex128:
+		if r, ok := realPart(val); ok {
+			tr.add(StepConvert, "imaginary part is 0, using real part %v", r)
+			explainTruncate(tr, r, "uint64")
+		} else {
+			tr.add(StepConvert, "imaginary part is not 0 -> 0")
+		}
+	case bool:
+		tr.add(StepConvert, "bool %v -> %d", val, toUint64(val))
+	case uint, uint8, uint16, uint32, uint64:
+		tr.add(StepConvert, "integer %v", val)
+	default:
+		tr.add(StepConvert, "unsupported source type %T -> 0", v)
+	}
+	return toUint64(v)
+}
+
+func explainParseUint(tr *Trace, s string) uint64 {
+	u, err := strconv.ParseUint(s, 10, 64)
+	if err == nil {
+		tr.add(StepParse, "ParseUint(%q) = %d", s, u)
+		return u
+	}
+	tr.add(StepParse, "ParseUint(%q) failed: %v", s, unwrapNumErr(err))
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		tr.add(StepParse, "ParseFloat(%q) failed: %v -> 0", s, unwrapNumErr(err))
+		return 0
+	}
+	tr.add(StepParse, "ParseFloat(%q) = %v", s, f)
+	explainTruncate(tr, f, "uint64")
+	return floatToUint64(f)
+}
+
+// explainTruncate 记录浮点数转整数时的截断与饱和
+func explainTruncate(tr *Trace, f float64, to string) {
+	var i any
+	if to == "uint64" {
+		i = floatToUint64(f)
+	} else {
+		i = floatToInt64(f)
+	}
+	switch {
+	case math.IsNaN(f):
+		tr.add(StepConvert, "NaN -> 0")
+	case fmt.Sprint(i) != strconv.FormatFloat(math.Trunc(f), 'f', -1, 64):
+		tr.add(StepConvert, "%v is outside %s range, saturates to %v", f, to, i)
+	case f != math.Trunc(f):
+		tr.add(StepConvert, "truncate %v -> %v", f, i)
+	}
+}
+
+// explainNarrow 记录 64 位中间值收窄为目标类型时的回绕
+func explainNarrow(tr *Trace, wide, narrow string) {
+	if wide != narrow {
+		tr.add(StepNarrow, "%s does not fit, wraps to %s", wide, narrow)
+	}
+}
+
+func explainFloat64(tr *Trace, v any) float64 {
+	switch val := v.(type) {
+	case nil:
+		tr.add(StepConvert, "nil -> 0")
+	case string, json.Number:
+		s := toString(val)
+		if f, err := strconv.ParseFloat(s, 64); err != nil {
+			tr.add(StepParse, "ParseFloat(%q) failed: %v -> 0", s, unwrapNumErr(err))
+		} else {
+			tr.add(StepParse, "ParseFloat(%q) = %v", s, f)
+		}
+	case complex64, complex128:
+		if r, ok := realPart(val); ok {
+			tr.add(StepConvert, "imaginary part is 0, using real part %v", r)
+		} else {
+			tr.add(StepConvert, "imaginary part is not 0 -> 0")
+		}
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		tr.add(StepConvert, "%T %v -> float64 %v", val, val, toFloat64(val))
+	default:
+		tr.add(StepConvert, "unsupported source type %T -> 0", v)
+	}
+	return toFloat64(v)
+}
+
+func explainComplex(tr *Trace, v any) {
+	switch val := v.(type) {
+	case string, json.Number:
+		s := toString(val)
+		if c, err := strconv.ParseComplex(s, 128); err != nil {
+			tr.add(StepParse, "ParseComplex(%q) failed: %v -> 0", s, unwrapNumErr(err))
+		} else {
+			tr.add(StepParse, "ParseComplex(%q) = %v", s, c)
+		}
+	case nil, complex64, complex128:
+	default:
+		explainFloat64(tr, v)
+	}
+}
+
+func explainBool(tr *Trace, v any) {
+	switch val := v.(type) {
+	case nil, bool:
+	case string:
+		if parseBool(val) {
+			tr.add(StepParse, "%q is a recognized true spelling", val)
+		} else {
+			tr.add(StepParse, "%q is not a recognized true spelling -> false", val)
+		}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		tr.add(StepConvert, "numeric %v == 1 -> %v", val, toBool(val))
+	default:
+		tr.add(StepConvert, "unsupported source type %T -> false", v)
+	}
+}
+
+// explainString 记录 toString 选择的格式化分支, 顺序与 toString 的 switch 一致
+func explainString(tr *Trace, v any) {
+	switch val := v.(type) {
+	case nil:
+		tr.add(StepConvert, "nil -> \"\"")
+	case string, json.Number, []byte, []rune:
+		tr.add(StepConvert, "used as text")
+	case fmt.Stringer:
+		tr.add(StepConvert, "String() method")
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		tr.add(StepConvert, "decimal format")
+	case float32, float64:
+		f := toFloat64(val)
+		switch {
+		case math.Floor(f) == f && inInt64Range(f):
+			tr.add(StepConvert, "integral float, formatted without decimals")
+		case math.Floor(f) == f:
+			tr.add(StepConvert, "integral float outside int64 range or ±Inf, shortest format")
+		case tr.frozen():
+			tr.add(StepConvert, "fractional float, rounded to 2 decimals")
+		default:
+			tr.add(StepConvert, "fractional float, shortest round-trip format")
+		}
+	case complex64, complex128:
+		tr.add(StepConvert, "complex format without parentheses")
+	case encoding.TextMarshaler:
+		tr.add(StepConvert, "MarshalText() method")
+	default:
+		tr.add(StepConvert, "no text form, JSON fallback")
+	}
+}
+
+// explainOther 记录非基础类型经过的分支, 顺序与 convertOther 一致
+func explainOther[T any](tr *Trace, v any) {
+	if _, ok := v.(T); ok {
+		tr.add(StepTarget, "input already has type %s", tr.Target)
+		return
+	}
+	if _, ok, err := convertStd[T](v); ok {
+		tr.add(StepTarget, "dedicated parser for %s", tr.Target)
+		if err != nil {
+			tr.add(StepParse, "failed: %v", err)
+		}
+		return
+	}
+	if _, ok, err := unmarshalTo[T](v); ok {
+		var zero T
+		if _, isText := any(&zero).(encoding.TextUnmarshaler); isText {
+			tr.add(StepTarget, "*%s implements encoding.TextUnmarshaler", tr.Target)
+		} else {
+			tr.add(StepTarget, "*%s implements json.Unmarshaler", tr.Target)
+		}
+		if err != nil {
+			tr.add(StepParse, "failed: %v", err)
+		}
+		return
+	}
+	tr.add(StepTarget, "no conversion from %s to %s -> zero value", tr.Source, tr.Target)
+}
+
+// explainPolicy 记录配置档与严格模式对结果的影响
+func explainPolicy(tr *Trace, value any) {
+	switch {
+	case tr.live:
+		if tr.Input != nil && tr.Err != nil && isZero(value) {
+			tr.add(StepPolicy, "ToE: zero result from non-nil input is an error")
+		} else if tr.Err != nil && strings.Contains(tr.Err.Error(), "overflows") {
+			tr.add(StepPolicy, "ToE: values outside the target range are rejected")
+		}
+	case tr.Profile == ProfileLegacy:
+		if tr.Input != nil && tr.Err != nil && isZero(value) {
+			tr.add(StepPolicy, "legacy: zero result from non-nil input is an error")
+		}
+	case tr.Profile == ProfileLenient:
+		if tr.Input == nil {
+			tr.add(StepPolicy, "lenient: nil converts to the zero value")
+		}
+	}
+}
+
+// isZero 判断结果是否为零值, 接口类型的 nil 结果不能交给 reflect.ValueOf
+// Optional 的 ToE 错误来自内层转换, 按内层的值判断
+func isZero(v any) bool {
+	if o, ok := v.(interface{ unwrap() any }); ok {
+		v = o.unwrap()
+	}
+	return v == nil || reflect.ValueOf(v).IsZero()
+}
+
+// unwrapNumErr 去掉 strconv.NumError 中重复的函数名与输入
+func unwrapNumErr(err error) error {
+	if ne, ok := err.(*strconv.NumError); ok {
+		return ne.Err
+	}
+	return err
+}
@@ -0,0 +1,242 @@
+package many
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lwmacct/250300-go-mod-many/internal/conformance"
+)
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		name    string
+		trace   Trace
+		steps   []string
+		wantErr bool
+	}{
+		{
+			"字符串回退到 ParseFloat",
+			Explain[int]("1e3"),
+			[]string{`parse: ParseInt("1e3") failed`, `parse: ParseFloat("1e3") = 1000`, "result: 1000"},
+			false,
+		},
+		{
+			"收窄回绕",
+			Explain[uint8]("300"),
+			[]string{`parse: ParseUint("300") = 300`, "narrow: 300 does not fit, wraps to 44", "result: To = 44, ToE error: value 300 overflows uint8"},
+			true,
+		},
+		{
+			"浮点数截断",
+			Explain[int8](3.9),
+			[]string{"convert: truncate 3.9 -> 3"},
+			false,
+		},
+		{
+			"浮点数饱和",
+			Explain[int64](1e300),
+			[]string{"convert: 1e+300 is outside int64 range, saturates to 9223372036854775807", "policy: ToE: values outside the target range are rejected"},
+			true,
+		},
+		{
+			"零值即错误",
+			Explain[int]("abc"),
+			[]string{`parse: ParseFloat("abc") failed: invalid syntax -> 0`, "policy: ToE: zero result from non-nil input is an error"},
+			true,
+		},
+		{
+			"负数转无符号",
+			Explain[uint](-3),
+			[]string{"convert: negative -3 clamps to 0"},
+			true,
+		},
+		{
+			"JSON 回退",
+			Explain[string](struct{ A int }{1}),
+			[]string{"convert: no text form, JSON fallback", `result: "{\"A\":1}"`},
+			false,
+		},
+		{
+			"专用解析器",
+			Explain[UUID]("x"),
+			[]string{"target: dedicated parser for many.UUID", "parse: failed: invalid UUID length"},
+			true,
+		},
+		{
+			"浮点数最短格式",
+			Explain[string](1.005),
+			[]string{"convert: fractional float, shortest round-trip format", `result: "1.005"`},
+			false,
+		},
+		{
+			"legacy 越界回绕不报错",
+			ExplainWith[uint8](NewConverter(), "300"),
+			[]string{"narrow: 300 does not fit, wraps to 44", "result: 44"},
+			false,
+		},
+		{
+			"legacy 保留两位小数",
+			ExplainWith[string](nil, 1.005),
+			[]string{"convert: fractional float, rounded to 2 decimals", `result: "1.00"`},
+			false,
+		},
+		{
+			"legacy 零值即错误",
+			ExplainWith[int](nil, "abc"),
+			[]string{"policy: legacy: zero result from non-nil input is an error"},
+			true,
+		},
+		{
+			"严格模式拒绝时不记录截断",
+			ExplainWith[int8](NewConverter(WithProfile(ProfileStrict)), 3.9),
+			[]string{"policy: strict: conversion loses information", "result: To = 0, ToE error"},
+			true,
+		},
+		{
+			"严格模式拒绝无法解析的输入",
+			ExplainWith[int](NewConverter(WithProfile(ProfileStrict)), "abc"),
+			[]string{"policy: strict: input rejected: cannot convert string to int"},
+			true,
+		},
+		{
+			"宽松与严格模式",
+			ExplainWith[int](NewConverter(WithProfile(ProfileLenient), WithStrict()), " 1.5"),
+			[]string{`policy: lenient: input normalized to string "1.5"`, "policy: strict: conversion loses information"},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.trace.Err != nil) != tt.wantErr {
+				t.Errorf("Err = %v, wantErr %v", tt.trace.Err, tt.wantErr)
+			}
+			var lines []string
+			for _, s := range tt.trace.Steps {
+				lines = append(lines, string(s.Kind)+": "+s.Detail)
+			}
+			all := strings.Join(lines, "\n")
+			for _, want := range tt.steps {
+				if !strings.Contains(all, want) {
+					t.Errorf("缺少步骤 %q, 实际:\n%s", want, all)
+				}
+			}
+		})
+	}
+}
+
+func TestExplainInterfaceTarget(t *testing.T) {
+	tr := Explain[error]("x")
+	if tr.Target != "error" || tr.Value != nil || tr.Err == nil {
+		t.Errorf("Explain[error](\"x\") = %v, %v, target %q", tr.Value, tr.Err, tr.Target)
+	}
+	if !strings.Contains(tr.String(), "policy:  ToE: zero result from non-nil input is an error") {
+		t.Errorf("缺少零值即错误的步骤:\n%s", tr)
+	}
+
+	tr = ExplainWith[fmt.Stringer](NewConverter(WithProfile(ProfileStrict)), NilUUID)
+	if tr.Value != NilUUID || tr.Err != nil {
+		t.Errorf("ExplainWith[fmt.Stringer](NilUUID) = %v, %v", tr.Value, tr.Err)
+	}
+	if tr = ExplainWith[error](nil, nil); tr.Value != nil || tr.Err != nil {
+		t.Errorf("ExplainWith[error](nil) = %v, %v", tr.Value, tr.Err)
+	}
+}
+
+func TestExplainMatchesTo(t *testing.T) {
+	// Trace 的结果必须与 To/ToE 一致
+	for _, v := range []any{nil, "12", "300", "-1", 3.7, 1e30, true, "x"} {
+		tr := Explain[uint8](v)
+		_, err := ToE[uint8](v)
+		if tr.Value != To[uint8](v) || (tr.Err != nil) != (err != nil) {
+			t.Errorf("Explain[uint8](%#v) = %v, %v, To/ToE = %v, %v", v, tr.Value, tr.Err, To[uint8](v), err)
+		}
+	}
+}
+
+// explainTargets 目标类型名到 Explain 的映射, Converter 为 nil 时使用 Explain
+var explainTargets = map[string]func(*Converter, any) Trace{
+	"bool":    explainCell[bool],
+	"string":  explainCell[string],
+	"int":     explainCell[int],
+	"int8":    explainCell[int8],
+	"int16":   explainCell[int16],
+	"int32":   explainCell[int32],
+	"int64":   explainCell[int64],
+	"uint":    explainCell[uint],
+	"uint8":   explainCell[uint8],
+	"uint16":  explainCell[uint16],
+	"uint32":  explainCell[uint32],
+	"uint64":  explainCell[uint64],
+	"float32": explainCell[float32],
+	"float64": explainCell[float64],
+
+	"Optional[int]":    explainCell[Optional[int]],
+	"Optional[uint8]":  explainCell[Optional[uint8]],
+	"Optional[string]": explainCell[Optional[string]],
+}
+
+func explainCell[T any](c *Converter, v any) Trace {
+	if c == nil {
+		return Explain[T](v)
+	}
+	return ExplainWith[T](c, v)
+}
+
+func TestExplainStepsMatchResult(t *testing.T) {
+	// 步骤必须反映实际执行的路径: 转换前被拒绝时不出现转换步骤, 有错误时必须有说明错误的规则
+	converters := map[string]*Converter{
+		"To/ToE":         nil,
+		"legacy":         NewConverter(),
+		"legacy+strict":  NewConverter(WithStrict()),
+		"strict":         NewConverter(WithProfile(ProfileStrict)),
+		"lenient":        NewConverter(WithProfile(ProfileLenient)),
+		"lenient+strict": NewConverter(WithProfile(ProfileLenient), WithStrict()),
+	}
+	for _, tc := range append(append([]conformance.Case{}, conformance.Cases...), profileCases...) {
+		for name, c := range converters {
+			tr := explainTargets[tc.Target](c, tc.Input)
+			// ProfileStrict 与 ProfileLenient 的错误都来自转换前的检查, legacy 与 To/ToE 在转换后判断零值与范围
+			exact := c != nil && c.Profile() != ProfileLegacy
+
+			var policy, converted, rejected bool
+			for _, s := range tr.Steps {
+				switch s.Kind {
+				case StepPolicy:
+					policy = true
+					rejected = rejected || strings.Contains(s.Detail, "rejected") ||
+						strings.Contains(s.Detail, "loses information") || strings.Contains(s.Detail, "is an error")
+				case StepTarget, StepParse, StepConvert, StepNarrow:
+					converted = true
+				}
+			}
+			if last := tr.Steps[len(tr.Steps)-1]; last.Kind != StepResult || !strings.Contains(last.Detail, quote(tr.Value)) {
+				t.Errorf("%s/%s: 最后一步 %v 与结果 %v 不一致", tc.Name, name, last, tr.Value)
+			}
+			switch {
+			case tr.Err != nil && !policy:
+				t.Errorf("%s/%s: 错误 %v 没有对应的规则步骤:\n%s", tc.Name, name, tr.Err, tr)
+			case tr.Err != nil && exact && converted:
+				t.Errorf("%s/%s: 输入在转换前被拒绝, 不应出现转换步骤:\n%s", tc.Name, name, tr)
+			case tr.Err == nil && rejected:
+				t.Errorf("%s/%s: 转换成功, 不应出现拒绝的步骤:\n%s", tc.Name, name, tr)
+			}
+		}
+	}
+}
+
+func TestTraceString(t *testing.T) {
+	got := Explain[uint8]("300").String()
+	want := `string -> uint8 (To/ToE)
+  source:  string "300"
+  target:  unsigned integer via uint64
+  parse:   ParseUint("300") = 300
+  narrow:  300 does not fit, wraps to 44
+  policy:  ToE: values outside the target range are rejected
+  result:  To = 44, ToE error: value 300 overflows uint8
+`
+	if got != want {
+		t.Errorf("String() =\n%s\n期望\n%s", got, want)
+	}
+}
@@ -0,0 +1,146 @@
+package many
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// smallIntCount 预先格式化的非负整数个数
+const smallIntCount = 1024
+
+// smallInts 0 到 smallIntCount-1 的十进制字符串, 共用同一块底层内存
+var smallInts = func() [smallIntCount]string {
+	var table [smallIntCount]string
+	buf := make([]byte, 0, smallIntCount*4)
+	ends := make([]int, smallIntCount)
+	for i := range smallIntCount {
+		buf = strconv.AppendInt(buf, int64(i), 10)
+		ends[i] = len(buf)
+	}
+	all := string(buf)
+	start := 0
+	for i, end := range ends {
+		table[i] = all[start:end]
+		start = end
+	}
+	return table
+}()
+
+// formatInt 与 strconv.FormatInt(i, 10) 相同, 小整数直接查表不分配内存
+func formatInt(i int64) string {
+	if 0 <= i && i < smallIntCount {
+		return smallInts[i]
+	}
+	return strconv.FormatInt(i, 10)
+}
+
+// formatUint 与 strconv.FormatUint(u, 10) 相同, 小整数直接查表不分配内存
+func formatUint(u uint64) string {
+	if u < smallIntCount {
+		return smallInts[u]
+	}
+	return strconv.FormatUint(u, 10)
+}
+
+// appendInt 将 i 的十进制形式追加到 dst
+func appendInt(dst []byte, i int64) []byte {
+	if 0 <= i && i < smallIntCount {
+		return append(dst, smallInts[i]...)
+	}
+	return strconv.AppendInt(dst, i, 10)
+}
+
+// appendUint 将 u 的十进制形式追加到 dst
+func appendUint(dst []byte, u uint64) []byte {
+	if u < smallIntCount {
+		return append(dst, smallInts[u]...)
+	}
+	return strconv.AppendUint(dst, u, 10)
+}
+
+// formatFloat 按 toString 的规则格式化浮点数: 整数形式不带小数点, 否则使用能解析回原值的最短小数形式
+func formatFloat(f float64, bitSize int) string {
+	if math.Floor(f) == f && inInt64Range(f) {
+		return formatInt(int64(f))
+	}
+	return strconv.FormatFloat(f, 'f', -1, bitSize)
+}
+
+// appendFloat 与 formatFloat 相同, 结果追加到 dst
+func appendFloat(dst []byte, f float64, bitSize int) []byte {
+	if math.Floor(f) == f && inInt64Range(f) {
+		return appendInt(dst, int64(f))
+	}
+	return strconv.AppendFloat(dst, f, 'f', -1, bitSize)
+}
+
+// inInt64Range 判断 f 能否无溢出地转换为 int64
+func inInt64Range(f float64) bool {
+	return f >= math.MinInt64 && f < math.MaxInt64
+}
+
+// appendComplex 追加不带外层括号的复数, 如 "1+2i"
+func appendComplex(dst []byte, c complex128, bitSize int) []byte {
+	bitSize /= 2
+	dst = strconv.AppendFloat(dst, real(c), 'g', -1, bitSize)
+	// 与 strconv.FormatComplex 一致, 虚部总是带符号
+	if im := imag(c); math.IsNaN(im) || !math.Signbit(im) {
+		dst = append(dst, '+')
+	}
+	dst = strconv.AppendFloat(dst, imag(c), 'g', -1, bitSize)
+	return append(dst, 'i')
+}
+
+// AppendTo 将 v 按 To[string] 的规则格式化后追加到 dst 并返回扩展后的切片,
+// 基础类型直接写入 dst, 不产生中间字符串
+func AppendTo(dst []byte, v any) []byte {
+	switch val := v.(type) {
+	case nil:
+		return dst
+	case string:
+		return append(dst, val...)
+	case json.Number:
+		return append(dst, val...)
+	case []byte:
+		return append(dst, val...)
+	case []rune:
+		for _, r := range val {
+			dst = utf8.AppendRune(dst, r)
+		}
+		return dst
+	case bool:
+		return strconv.AppendBool(dst, val)
+	case int:
+		return appendInt(dst, int64(val))
+	case int8:
+		return appendInt(dst, int64(val))
+	case int16:
+		return appendInt(dst, int64(val))
+	case int32:
+		return appendInt(dst, int64(val))
+	case int64:
+		return appendInt(dst, val)
+	case uint:
+		return appendUint(dst, uint64(val))
+	case uint8:
+		return appendUint(dst, uint64(val))
+	case uint16:
+		return appendUint(dst, uint64(val))
+	case uint32:
+		return appendUint(dst, uint64(val))
+	case uint64:
+		return appendUint(dst, val)
+	case float32:
+		return appendFloat(dst, float64(val), 32)
+	case float64:
+		return appendFloat(dst, val, 64)
+	case complex64:
+		return appendComplex(dst, complex128(val), 64)
+	case complex128:
+		return appendComplex(dst, val, 128)
+	default:
+		return append(dst, toString(val)...)
+	}
+}
@@ -0,0 +1,72 @@
+package many
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestFormatInt(t *testing.T) {
+	for _, i := range []int64{-1025, -1, 0, 1, 99, 100, 1023, 1024, math.MaxInt64, math.MinInt64} {
+		if got, want := formatInt(i), strconv.FormatInt(i, 10); got != want {
+			t.Errorf("formatInt(%d) = %q, 期望 %q", i, got, want)
+		}
+		if got, want := string(appendInt([]byte("x"), i)), "x"+strconv.FormatInt(i, 10); got != want {
+			t.Errorf("appendInt(%d) = %q, 期望 %q", i, got, want)
+		}
+	}
+	for _, u := range []uint64{0, 7, 1023, 1024, math.MaxUint64} {
+		if got, want := formatUint(u), strconv.FormatUint(u, 10); got != want {
+			t.Errorf("formatUint(%d) = %q, 期望 %q", u, got, want)
+		}
+	}
+}
+
+func TestAppendTo(t *testing.T) {
+	type point struct{ X, Y int }
+
+	values := []any{
+		nil, "hello", json.Number("1.5"), []byte("raw"), []rune("你好"),
+		true, false, 0, -42, 1023, 123456, int8(-8), int16(16), int32(-32), int64(math.MinInt64),
+		uint(7), uint8(255), uint16(1024), uint32(32), uint64(math.MaxUint64),
+		float32(1.5), float32(3), 123.0, 123.456, -0.5,
+		complex(1, 2), complex64(complex(0.5, -1)), complex(math.NaN(), math.Inf(-1)),
+		NilUUID, point{1, 2},
+	}
+
+	for _, v := range values {
+		got := string(AppendTo([]byte("> "), v))
+		if want := "> " + To[string](v); got != want {
+			t.Errorf("AppendTo(%#v) = %q, 期望 %q", v, got, want)
+		}
+	}
+}
+
+func TestAppendToZeroAlloc(t *testing.T) {
+	var (
+		small any = 42
+		large any = 1234567
+		f     any = 3.25
+		s     any = "key"
+	)
+	buf := make([]byte, 0, 64)
+
+	if n := testing.AllocsPerRun(100, func() { _ = To[string](small) }); n != 0 {
+		t.Errorf("To[string](小整数) 每次分配 %v 次, 期望 0", n)
+	}
+	for _, v := range []any{small, large, f, s} {
+		if n := testing.AllocsPerRun(100, func() { buf = AppendTo(buf[:0], v) }); n != 0 {
+			t.Errorf("AppendTo(%v) 每次分配 %v 次, 期望 0", v, n)
+		}
+	}
+}
+
+func BenchmarkAppendTo(b *testing.B) {
+	var v any = 1234567
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for b.Loop() {
+		buf = AppendTo(buf[:0], v)
+	}
+}
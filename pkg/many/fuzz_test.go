@@ -0,0 +1,190 @@
+package many
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+// 种子语料位于 testdata/fuzz/<FuzzName>/, 可用 go test -fuzz=<FuzzName> 持续模糊测试
+
+// FuzzStringToInt ToE 成功时, 结果必须等于字符串表示的数值 (浮点数按截断处理), 不能是回绕或截断后的值
+func FuzzStringToInt(f *testing.F) {
+	f.Fuzz(func(t *testing.T, s string) {
+		checkStringToInt[int](t, s)
+		checkStringToInt[int8](t, s)
+		checkStringToInt[int16](t, s)
+		checkStringToInt[int32](t, s)
+		checkStringToInt[int64](t, s)
+		checkStringToInt[uint](t, s)
+		checkStringToInt[uint8](t, s)
+		checkStringToInt[uint16](t, s)
+		checkStringToInt[uint32](t, s)
+		checkStringToInt[uint64](t, s)
+	})
+}
+
+func checkStringToInt[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64](t *testing.T, s string) {
+	t.Helper()
+	_ = To[T](s)
+	got, err := ToE[T](s)
+	if err != nil {
+		if got != 0 {
+			t.Errorf("ToE[%T](%q) 返回错误时值应为零值, 得到 %v", got, s, got)
+		}
+		return
+	}
+
+	var want string
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		want = strconv.FormatInt(i, 10)
+	} else if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		want = strconv.FormatUint(u, 10)
+	} else if fl, err := strconv.ParseFloat(s, 64); err == nil {
+		want = big.NewFloat(math.Trunc(fl)).Text('f', 0)
+	} else {
+		t.Fatalf("ToE[%T](%q) = %v, 无法解析的字符串应返回错误", got, s, got)
+	}
+	if gotStr := fmt.Sprint(got); gotStr != want {
+		t.Errorf("ToE[%T](%q) = %s, 期望 %s", got, s, gotStr, want)
+	}
+}
+
+// FuzzStringToFloat ToE 成功时, 结果必须等于 strconv.ParseFloat 的结果, float32 不能溢出为 ±Inf
+func FuzzStringToFloat(f *testing.F) {
+	f.Fuzz(func(t *testing.T, s string) {
+		want, parseErr := strconv.ParseFloat(s, 64)
+
+		got64, err := ToE[float64](s)
+		if err == nil {
+			if parseErr != nil || !sameFloat(got64, want) {
+				t.Errorf("ToE[float64](%q) = %v, ParseFloat = %v, %v", s, got64, want, parseErr)
+			}
+		}
+
+		got32, err := ToE[float32](s)
+		if err == nil {
+			if parseErr != nil || !sameFloat(float64(got32), float64(float32(want))) {
+				t.Errorf("ToE[float32](%q) = %v, ParseFloat = %v, %v", s, got32, want, parseErr)
+			}
+			if math.IsInf(float64(got32), 0) && !math.IsInf(want, 0) {
+				t.Errorf("ToE[float32](%q) = %v, 溢出应返回错误", s, got32)
+			}
+		}
+	})
+}
+
+// FuzzStringToBool ToE[bool] 成功时结果只能是 true, 且 To 与 ToE 一致
+func FuzzStringToBool(f *testing.F) {
+	f.Fuzz(func(t *testing.T, s string) {
+		got, err := ToE[bool](s)
+		if err == nil && !got {
+			t.Errorf("ToE[bool](%q) = false, nil", s)
+		}
+		if err != nil && To[bool](s) {
+			t.Errorf("ToE[bool](%q) 返回错误, 但 To[bool] = true", s)
+		}
+	})
+}
+
+// FuzzIntRoundTrip 整数经字符串往返后不变
+func FuzzIntRoundTrip(f *testing.F) {
+	f.Fuzz(func(t *testing.T, n int64, u uint64) {
+		if got := To[int64](To[string](n)); got != n {
+			t.Errorf("To[int64](To[string](%d)) = %d", n, got)
+		}
+		if got := To[uint64](To[string](u)); got != u {
+			t.Errorf("To[uint64](To[string](%d)) = %d", u, got)
+		}
+		if got := To[int32](To[string](int32(n))); got != int32(n) {
+			t.Errorf("To[int32](To[string](%d)) = %d", int32(n), got)
+		}
+		if n != 0 {
+			if got, err := ToE[int64](To[string](n)); err != nil || got != n {
+				t.Errorf("ToE[int64](To[string](%d)) = %d, %v", n, got, err)
+			}
+		}
+	})
+}
+
+// FuzzFloatRoundTrip 浮点数经字符串往返后精确还原
+func FuzzFloatRoundTrip(f *testing.F) {
+	f.Fuzz(func(t *testing.T, x float64) {
+		if math.IsNaN(x) {
+			return
+		}
+		s := To[string](x)
+		back, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			t.Fatalf("To[string](%v) = %q, 无法解析: %v", x, s, err)
+		}
+		if got := To[float64](s); !sameFloat(got, back) {
+			t.Errorf("To[float64](%q) = %v, 期望 %v", s, got, back)
+		}
+
+		if back != x {
+			t.Errorf("浮点数 %v 往返后为 %v (%q)", x, back, s)
+		}
+	})
+}
+
+// FuzzToAny 任意输入都不能 panic, 且 ToE 成功时与 To 的结果一致
+func FuzzToAny(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var inputs []any
+		var decoded any
+		if err := json.Unmarshal(data, &decoded); err == nil {
+			inputs = append(inputs, decoded)
+		}
+		var num json.Number
+		if err := json.Unmarshal(data, &num); err == nil {
+			inputs = append(inputs, num)
+		}
+		inputs = append(inputs, string(data), data)
+
+		for _, v := range inputs {
+			checkToAny[bool](t, v)
+			checkToAny[string](t, v)
+			checkToAny[int](t, v)
+			checkToAny[int8](t, v)
+			checkToAny[uint16](t, v)
+			checkToAny[uint64](t, v)
+			checkToAny[float32](t, v)
+			checkToAny[float64](t, v)
+			checkToAny[complex128](t, v)
+			_ = To[[]byte](v)
+			_ = To[UUID](v)
+			_ = To[ULID](v)
+			_, _ = ToE[UUID](v)
+		}
+	})
+}
+
+func checkToAny[T comparable](t *testing.T, v any) {
+	t.Helper()
+	plain := To[T](v)
+	got, err := ToE[T](v)
+	if err == nil && got != plain && !(isNaN(got) && isNaN(plain)) {
+		t.Errorf("ToE[%T](%#v) = %v, 但 To = %v", got, v, got, plain)
+	}
+}
+
+func isNaN(v any) bool {
+	switch f := v.(type) {
+	case float32:
+		return f != f
+	case float64:
+		return f != f
+	case complex128:
+		return real(f) != real(f) || imag(f) != imag(f)
+	}
+	return false
+}
+
+// sameFloat 比较浮点数, NaN 与 NaN 视为相等
+func sameFloat(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
+}
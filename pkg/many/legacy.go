@@ -0,0 +1,531 @@
+package many
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+)
+
+// 本文件是 ProfileLegacy 的冻结实现, 保留引入配置档之前 To/ToE 对基础类型的行为:
+// 整数越界时回绕且 ToE 不报错, 带小数的浮点数格式化为两位小数, ToE 仅在非 nil 输入得到零值时返回错误
+// To/ToE 此后的修正不会影响这里, 除非提升主版本, 否则不要修改本文件的行为
+//
+// 原实现中越界浮点数直接转换为整数, 结果由平台决定, 这里统一冻结为取边界值, NaN 为 0
+
+// 基础类型的 legacy dispatcher, 只提供 convert 与 convertE
+var (
+	legacyBoolDispatcher       = legacyPrimitive(legacyToBool)
+	legacyStringDispatcher     = legacyPrimitive(legacyToString)
+	legacyIntDispatcher        = legacyPrimitive(func(v any) int { return int(legacyToInt64(v)) })
+	legacyInt8Dispatcher       = legacyPrimitive(func(v any) int8 { return int8(legacyToInt64(v)) })
+	legacyInt16Dispatcher      = legacyPrimitive(func(v any) int16 { return int16(legacyToInt64(v)) })
+	legacyInt32Dispatcher      = legacyPrimitive(func(v any) int32 { return int32(legacyToInt64(v)) })
+	legacyInt64Dispatcher      = legacyPrimitive(legacyToInt64)
+	legacyUintDispatcher       = legacyPrimitive(func(v any) uint { return uint(legacyToUint64(v)) })
+	legacyUint8Dispatcher      = legacyPrimitive(func(v any) uint8 { return uint8(legacyToUint64(v)) })
+	legacyUint16Dispatcher     = legacyPrimitive(func(v any) uint16 { return uint16(legacyToUint64(v)) })
+	legacyUint32Dispatcher     = legacyPrimitive(func(v any) uint32 { return uint32(legacyToUint64(v)) })
+	legacyUint64Dispatcher     = legacyPrimitive(legacyToUint64)
+	legacyFloat32Dispatcher    = legacyPrimitive(func(v any) float32 { return float32(legacyToFloat64(v)) })
+	legacyFloat64Dispatcher    = legacyPrimitive(legacyToFloat64)
+	legacyComplex64Dispatcher  = legacyPrimitive(func(v any) complex64 { return complex64(legacyToComplex128(v)) })
+	legacyComplex128Dispatcher = legacyPrimitive(legacyToComplex128)
+	legacyBytesDispatcher      = legacySlice(legacyToBytes)
+	legacyRunesDispatcher      = legacySlice(legacyToRunes)
+)
+
+// legacyDispatcherOf 返回 ProfileLegacy 使用的 dispatcher,
+// 基础类型使用冻结的实现, 其他类型由标准库或类型自身的方法解析, 与 dispatcherOf 相同
+func legacyDispatcherOf[T any]() *dispatcher[T] {
+	var d any
+	switch any((*T)(nil)).(type) {
+	case *bool:
+		d = legacyBoolDispatcher
+	case *string:
+		d = legacyStringDispatcher
+	case *int:
+		d = legacyIntDispatcher
+	case *int8:
+		d = legacyInt8Dispatcher
+	case *int16:
+		d = legacyInt16Dispatcher
+	case *int32:
+		d = legacyInt32Dispatcher
+	case *int64:
+		d = legacyInt64Dispatcher
+	case *uint:
+		d = legacyUintDispatcher
+	case *uint8:
+		d = legacyUint8Dispatcher
+	case *uint16:
+		d = legacyUint16Dispatcher
+	case *uint32:
+		d = legacyUint32Dispatcher
+	case *uint64:
+		d = legacyUint64Dispatcher
+	case *float32:
+		d = legacyFloat32Dispatcher
+	case *float64:
+		d = legacyFloat64Dispatcher
+	case *complex64:
+		d = legacyComplex64Dispatcher
+	case *complex128:
+		d = legacyComplex128Dispatcher
+	case *[]byte:
+		d = legacyBytesDispatcher
+	case *[]rune:
+		d = legacyRunesDispatcher
+	default:
+		return legacyProvided[T]()
+	}
+	return d.(*dispatcher[T])
+}
+
+// legacyDispatchers 以 (*T)(nil) 为键缓存 dispatcherProvider 构建的 legacy dispatcher
+var legacyDispatchers sync.Map
+
+// legacyProvided 为实现 dispatcherProvider 的类型构建 legacy dispatcher, 其他类型与 dispatcherOf 相同
+func legacyProvided[T any]() *dispatcher[T] {
+	p, ok := any((*T)(nil)).(dispatcherProvider)
+	if !ok {
+		return dispatcherOf[T]()
+	}
+	key := any((*T)(nil))
+	if d, ok := legacyDispatchers.Load(key); ok {
+		return d.(*dispatcher[T])
+	}
+	d, _ := legacyDispatchers.LoadOrStore(key, p.newDispatcher(true))
+	return d.(*dispatcher[T])
+}
+
+// legacyPrimitive 构建基础类型的 legacy dispatcher, ToE 在非 nil 输入得到零值时返回错误
+func legacyPrimitive[P comparable](conv func(any) P) *dispatcher[P] {
+	return &dispatcher[P]{
+		convert: conv,
+		convertE: func(v any) (P, error) {
+			var zero P
+			result := conv(v)
+			if v != nil && result == zero {
+				return zero, fmt.Errorf("cannot convert %T to %T", v, zero)
+			}
+			return result, nil
+		},
+	}
+}
+
+// legacySlice 构建 []byte, []rune 的 legacy dispatcher, ToE 在非 nil 输入得到 nil 时返回错误
+func legacySlice[E any](conv func(any) []E) *dispatcher[[]E] {
+	return &dispatcher[[]E]{
+		convert: conv,
+		convertE: func(v any) ([]E, error) {
+			result := conv(v)
+			if v != nil && result == nil {
+				return nil, fmt.Errorf("cannot convert %T to %T", v, result)
+			}
+			return result, nil
+		},
+	}
+}
+
+func legacyToFloat64(v any) float64 {
+	switch val := v.(type) {
+	case string:
+		return legacyParseFloat64(val)
+	case bool:
+		if val {
+			return 1
+		}
+		return 0
+	case int:
+		return float64(val)
+	case int8:
+		return float64(val)
+	case int16:
+		return float64(val)
+	case int32:
+		return float64(val)
+	case int64:
+		return float64(val)
+	case uint:
+		return float64(val)
+	case uint8:
+		return float64(val)
+	case uint16:
+		return float64(val)
+	case uint32:
+		return float64(val)
+	case uint64:
+		return float64(val)
+	case float32:
+		return float64(val)
+	case float64:
+		return val
+	case complex64, complex128:
+		if r, ok := legacyRealPart(val); ok {
+			return r
+		}
+		return 0
+	case json.Number:
+		if f, err := val.Float64(); err == nil {
+			return f
+		}
+		return 0
+	default:
+		return 0
+	}
+}
+
+func legacyToInt64(v any) int64 {
+	switch val := v.(type) {
+	case int:
+		return int64(val)
+	case int8:
+		return int64(val)
+	case int16:
+		return int64(val)
+	case int32:
+		return int64(val)
+	case int64:
+		return val
+	case uint:
+		return int64(val)
+	case uint8:
+		return int64(val)
+	case uint16:
+		return int64(val)
+	case uint32:
+		return int64(val)
+	case uint64:
+		if val > math.MaxInt64 {
+			return math.MaxInt64
+		}
+		return int64(val)
+	case string:
+		return legacyParseInt64(val)
+	case float32:
+		return legacyFloatToInt64(float64(val))
+	case float64:
+		return legacyFloatToInt64(val)
+	case complex64, complex128:
+		if r, ok := legacyRealPart(val); ok {
+			return legacyFloatToInt64(r)
+		}
+		return 0
+	case bool:
+		if val {
+			return 1
+		}
+		return 0
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		if f, err := val.Float64(); err == nil {
+			return legacyFloatToInt64(f)
+		}
+		return 0
+	default:
+		return 0
+	}
+}
+
+func legacyToUint64(v any) uint64 {
+	switch val := v.(type) {
+	case uint:
+		return uint64(val)
+	case uint8:
+		return uint64(val)
+	case uint16:
+		return uint64(val)
+	case uint32:
+		return uint64(val)
+	case uint64:
+		return val
+	case int:
+		return legacyIntToUint64(int64(val))
+	case int8:
+		return legacyIntToUint64(int64(val))
+	case int16:
+		return legacyIntToUint64(int64(val))
+	case int32:
+		return legacyIntToUint64(int64(val))
+	case int64:
+		return legacyIntToUint64(val)
+	case string:
+		return legacyParseUint64(val)
+	case float32:
+		return legacyFloatToUint64(float64(val))
+	case float64:
+		return legacyFloatToUint64(val)
+	case complex64, complex128:
+		if r, ok := legacyRealPart(val); ok {
+			return legacyFloatToUint64(r)
+		}
+		return 0
+	case bool:
+		if val {
+			return 1
+		}
+		return 0
+	case json.Number:
+		if u, err := strconv.ParseUint(string(val), 10, 64); err == nil {
+			return u
+		}
+		if f, err := val.Float64(); err == nil {
+			return legacyFloatToUint64(f)
+		}
+		return 0
+	default:
+		return 0
+	}
+}
+
+// legacyToBool 只有 1 与常见的真值写法为 true, json.Number 等其他类型为 false
+func legacyToBool(v any) bool {
+	switch val := v.(type) {
+	case bool:
+		return val
+	case string:
+		return legacyParseBool(val)
+	case int:
+		return val == 1
+	case int8:
+		return val == 1
+	case int16:
+		return val == 1
+	case int32:
+		return val == 1
+	case int64:
+		return val == 1
+	case uint:
+		return val == 1
+	case uint8:
+		return val == 1
+	case uint16:
+		return val == 1
+	case uint32:
+		return val == 1
+	case uint64:
+		return val == 1
+	case float32:
+		return val == 1
+	case float64:
+		return val == 1
+	default:
+		return false
+	}
+}
+
+func legacyToComplex128(v any) complex128 {
+	switch val := v.(type) {
+	case complex128:
+		return val
+	case complex64:
+		return complex128(val)
+	case string:
+		return legacyParseComplex128(val)
+	case json.Number:
+		return legacyParseComplex128(string(val))
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return complex(legacyToFloat64(val), 0)
+	default:
+		return 0
+	}
+}
+
+func legacyToString(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case json.Number:
+		return string(val)
+	case []byte:
+		return string(val)
+	case []rune:
+		return string(val)
+	case fmt.Stringer:
+		return val.String()
+	case bool:
+		return strconv.FormatBool(val)
+	case int:
+		return strconv.FormatInt(int64(val), 10)
+	case int8:
+		return strconv.FormatInt(int64(val), 10)
+	case int16:
+		return strconv.FormatInt(int64(val), 10)
+	case int32:
+		return strconv.FormatInt(int64(val), 10)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case uint:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint64:
+		return strconv.FormatUint(val, 10)
+	case float32:
+		return legacyFormatFloat(float64(val), 32)
+	case float64:
+		return legacyFormatFloat(val, 64)
+	case complex64:
+		return legacyFormatComplex(complex128(val), 64)
+	case complex128:
+		return legacyFormatComplex(val, 128)
+	case encoding.TextMarshaler:
+		data, err := val.MarshalText()
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	default:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+}
+
+func legacyToBytes(v any) []byte {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return val
+	case string:
+		return []byte(val)
+	case []rune:
+		return []byte(string(val))
+	default:
+		return []byte(legacyToString(val))
+	}
+}
+
+func legacyToRunes(v any) []rune {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case []rune:
+		return val
+	case string:
+		return []rune(val)
+	case []byte:
+		return []rune(string(val))
+	default:
+		return []rune(legacyToString(val))
+	}
+}
+
+func legacyParseFloat64(s string) float64 {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return 0
+}
+
+func legacyParseInt64(s string) int64 {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return legacyFloatToInt64(f)
+	}
+	return 0
+}
+
+func legacyParseUint64(s string) uint64 {
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return u
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return legacyFloatToUint64(f)
+	}
+	return 0
+}
+
+func legacyParseBool(s string) bool {
+	switch s {
+	case "1", "t", "T", "true", "TRUE", "True", "yes", "YES", "Yes", "y", "Y", "on", "ON", "On":
+		return true
+	default:
+		return false
+	}
+}
+
+func legacyParseComplex128(s string) complex128 {
+	if c, err := strconv.ParseComplex(s, 128); err == nil {
+		return c
+	}
+	return 0
+}
+
+func legacyIntToUint64(i int64) uint64 {
+	if i < 0 {
+		return 0
+	}
+	return uint64(i)
+}
+
+func legacyFloatToInt64(f float64) int64 {
+	switch {
+	case f != f:
+		return 0
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	default:
+		return int64(f)
+	}
+}
+
+func legacyFloatToUint64(f float64) uint64 {
+	switch {
+	case f != f || f < 0:
+		return 0
+	case f >= math.MaxUint64:
+		return math.MaxUint64
+	default:
+		return uint64(f)
+	}
+}
+
+func legacyRealPart(v any) (float64, bool) {
+	var c complex128
+	switch val := v.(type) {
+	case complex64:
+		c = complex128(val)
+	case complex128:
+		c = val
+	}
+	if imag(c) != 0 {
+		return 0, false
+	}
+	return real(c), true
+}
+
+// legacyFormatFloat 整数形式不带小数点, 否则保留两位小数, 超出 int64 范围的整数与 ±Inf 按最短形式输出
+func legacyFormatFloat(f float64, bitSize int) string {
+	if math.Floor(f) == f {
+		if f >= math.MinInt64 && f < math.MaxInt64 {
+			return strconv.FormatInt(int64(f), 10)
+		}
+		return strconv.FormatFloat(f, 'f', -1, bitSize)
+	}
+	return strconv.FormatFloat(f, 'f', 2, bitSize)
+}
+
+// legacyFormatComplex 与 strconv.FormatComplex 相同但不带外层括号, 如 "1+2i"
+func legacyFormatComplex(c complex128, bitSize int) string {
+	s := strconv.FormatComplex(c, 'g', -1, bitSize)
+	return s[1 : len(s)-1]
+}
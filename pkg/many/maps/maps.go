@@ -0,0 +1,222 @@
+// Package maps 提供泛型 map 工具函数, 键类型转换委托给 many.To
+package maps
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/lwmacct/250300-go-mod-many/pkg/many"
+)
+
+// Keys 返回 m 的所有键, 顺序不确定
+func Keys[M ~map[K]V, K comparable, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// SortedKeys 返回 m 的所有键, 按升序排列
+func SortedKeys[M ~map[K]V, K cmp.Ordered, V any](m M) []K {
+	keys := Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
+// Values 返回 m 的所有值, 顺序不确定
+func Values[M ~map[K]V, K comparable, V any](m M) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// SortedValues 返回 m 的所有值, 按升序排列
+func SortedValues[M ~map[K]V, K comparable, V cmp.Ordered](m M) []V {
+	values := Values(m)
+	slices.Sort(values)
+	return values
+}
+
+// ValuesByKey 按键的升序返回 m 的所有值, 适用于值不可排序的情况
+func ValuesByKey[M ~map[K]V, K cmp.Ordered, V any](m M) []V {
+	values := make([]V, 0, len(m))
+	for _, k := range SortedKeys(m) {
+		values = append(values, m[k])
+	}
+	return values
+}
+
+// Invert 交换键与值, 多个键对应同一个值时保留其中最大的键, 结果是确定的
+func Invert[M ~map[K]V, K cmp.Ordered, V comparable](m M) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		if old, ok := result[v]; !ok || k > old {
+			result[v] = k
+		}
+	}
+	return result
+}
+
+// Filter 返回 fn 为 true 的键值对
+func Filter[M ~map[K]V, K comparable, V any](m M, fn func(K, V) bool) M {
+	result := make(M)
+	for k, v := range m {
+		if fn(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// MapValues 对每个值调用 fn, 键保持不变
+func MapValues[M ~map[K]V, K comparable, V, R any](m M, fn func(V) R) map[K]R {
+	result := make(map[K]R, len(m))
+	for k, v := range m {
+		result[k] = fn(v)
+	}
+	return result
+}
+
+// MapKeys 对每个键调用 fn, fn 将不同的键映射为同一个键时, 保留的值不确定
+func MapKeys[M ~map[K]V, K, R comparable, V any](m M, fn func(K) R) map[R]V {
+	result := make(map[R]V, len(m))
+	for k, v := range m {
+		result[fn(k)] = v
+	}
+	return result
+}
+
+// ConvertKeys 按 many.To 的规则转换每个键, 如 map[string]any 转 map[int]any
+// 无法转换的键得到零值, 冲突时保留的值不确定, 需要检查时使用 ConvertKeysE
+func ConvertKeys[R comparable, M ~map[K]V, K comparable, V any](m M) map[R]V {
+	return MapKeys(m, func(k K) R { return many.To[R](k) })
+}
+
+// ConvertKeysE 按 many.ToE 的规则转换每个键, 键无法转换或转换后冲突 (如 "1" 与 "01") 时返回错误
+func ConvertKeysE[R comparable, M ~map[K]V, K comparable, V any](m M) (map[R]V, error) {
+	result := make(map[R]V, len(m))
+	from := make(map[R]K, len(m))
+	for k, v := range m {
+		r, err := many.ToE[R](k)
+		if err != nil {
+			return nil, fmt.Errorf("key %v: %w", k, err)
+		}
+		if prev, ok := from[r]; ok {
+			return nil, fmt.Errorf("keys %v and %v both convert to %v", prev, k, r)
+		}
+		from[r] = k
+		result[r] = v
+	}
+	return result, nil
+}
+
+// Pick 返回只包含 keys 中的键的副本, m 中不存在的键忽略
+func Pick[M ~map[K]V, K comparable, V any](m M, keys ...K) M {
+	result := make(M, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Omit 返回去掉 keys 中的键的副本
+func Omit[M ~map[K]V, K comparable, V any](m M, keys ...K) M {
+	result := make(M, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	for _, k := range keys {
+		delete(result, k)
+	}
+	return result
+}
+
+// SliceStrategy DeepMerge 遇到两边都是切片时的处理方式
+type SliceStrategy int
+
+const (
+	// SliceReplace 使用 override 中的切片, 默认
+	SliceReplace SliceStrategy = iota
+	// SliceAppend 将 override 中的切片追加到 base 之后
+	SliceAppend
+	// SliceUnion 追加 base 中不存在的元素, 按 reflect.DeepEqual 判断重复
+	SliceUnion
+)
+
+// mergeConfig DeepMerge 的配置
+type mergeConfig struct {
+	slices SliceStrategy
+}
+
+// MergeOption DeepMerge 选项
+type MergeOption func(*mergeConfig)
+
+// WithSliceStrategy 设置切片的合并方式
+func WithSliceStrategy(s SliceStrategy) MergeOption {
+	return func(c *mergeConfig) {
+		c.slices = s
+	}
+}
+
+// DeepMerge 将 override 合并到 base 的副本中并返回, 两个参数都不会被修改
+// 两边都是 map[string]any 的键递归合并, 两边都是 []any 的键按 SliceStrategy 处理, 其余情况使用 override 的值
+// 结果中沿合并路径的 map 与追加或合并得到的切片是新建的, 其他值与输入共用
+func DeepMerge(base, override map[string]any, opts ...MergeOption) map[string]any {
+	cfg := mergeConfig{slices: SliceReplace}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return deepMerge(base, override, &cfg)
+}
+
+func deepMerge(base, override map[string]any, cfg *mergeConfig) map[string]any {
+	result := make(map[string]any, max(len(base), len(override)))
+	for k, v := range base {
+		result[k] = v
+	}
+	for k, v := range override {
+		old, ok := result[k]
+		if !ok {
+			result[k] = v
+			continue
+		}
+		switch ov := v.(type) {
+		case map[string]any:
+			if bm, ok := old.(map[string]any); ok {
+				result[k] = deepMerge(bm, ov, cfg)
+				continue
+			}
+		case []any:
+			if bs, ok := old.([]any); ok {
+				result[k] = mergeSlices(bs, ov, cfg.slices)
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
+func mergeSlices(base, override []any, strategy SliceStrategy) []any {
+	switch strategy {
+	case SliceAppend:
+		return slices.Concat(base, override)
+	case SliceUnion:
+		result := slices.Clone(base)
+		for _, v := range override {
+			if !slices.ContainsFunc(result, func(e any) bool { return reflect.DeepEqual(e, v) }) {
+				result = append(result, v)
+			}
+		}
+		return result
+	default:
+		return override
+	}
+}
@@ -0,0 +1,150 @@
+package maps
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKeysValues(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 3, "c": 1}
+
+	if got := SortedKeys(m); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("SortedKeys() = %v", got)
+	}
+	if got := SortedValues(m); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("SortedValues() = %v", got)
+	}
+	if got := ValuesByKey(m); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("ValuesByKey() = %v", got)
+	}
+	if got := Keys(m); len(got) != 3 {
+		t.Errorf("Keys() = %v", got)
+	}
+	if got := Values(map[int]string(nil)); len(got) != 0 {
+		t.Errorf("Values(nil) = %v", got)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	got := Invert(map[string]int{"a": 1, "b": 2, "c": 1})
+	// 值重复时保留最大的键
+	if want := map[int]string{1: "c", 2: "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Invert() = %v, 期望 %v", got, want)
+	}
+}
+
+func TestFilterMapValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	odd := Filter(m, func(_ string, v int) bool { return v%2 == 1 })
+	if want := map[string]int{"a": 1, "c": 3}; !reflect.DeepEqual(odd, want) {
+		t.Errorf("Filter() = %v", odd)
+	}
+
+	doubled := MapValues(m, func(v int) float64 { return float64(v) * 2 })
+	if doubled["c"] != 6 || len(doubled) != 3 {
+		t.Errorf("MapValues() = %v", doubled)
+	}
+
+	upper := MapKeys(m, strings.ToUpper)
+	if upper["B"] != 2 || len(upper) != 3 {
+		t.Errorf("MapKeys() = %v", upper)
+	}
+}
+
+func TestConvertKeys(t *testing.T) {
+	decoded := map[string]any{"1": "a", "2": "b", "x": "c"}
+
+	got := ConvertKeys[int](map[string]any{"1": "a", "2": "b"})
+	if want := map[int]any{1: "a", 2: "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ConvertKeys() = %v", got)
+	}
+
+	tests := []struct {
+		name    string
+		in      map[string]any
+		wantErr string
+	}{
+		{"无法转换", decoded, "key x: "},
+		{"转换后冲突", map[string]any{"1": "a", "01": "b"}, "both convert to 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ConvertKeysE[int](tt.in)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ConvertKeysE() error = %v, 期望包含 %q", err, tt.wantErr)
+			}
+		})
+	}
+	if got, err := ConvertKeysE[uint8](map[string]bool{"7": true}); err != nil || !got[7] {
+		t.Errorf("ConvertKeysE() = %v, %v", got, err)
+	}
+}
+
+func TestPickOmit(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	if got := Pick(m, "a", "c", "z"); !reflect.DeepEqual(got, map[string]int{"a": 1, "c": 3}) {
+		t.Errorf("Pick() = %v", got)
+	}
+	if got := Omit(m, "a", "z"); !reflect.DeepEqual(got, map[string]int{"b": 2, "c": 3}) {
+		t.Errorf("Omit() = %v", got)
+	}
+	if len(m) != 3 {
+		t.Errorf("Omit() 修改了输入: %v", m)
+	}
+}
+
+func TestDeepMerge(t *testing.T) {
+	base := map[string]any{
+		"name": "svc",
+		"db":   map[string]any{"host": "localhost", "port": 5432},
+		"tags": []any{"a", "b"},
+		"list": []any{1},
+	}
+	override := map[string]any{
+		"db":   map[string]any{"port": 6432, "user": "app"},
+		"tags": []any{"b", "c"},
+		"list": "scalar",
+	}
+
+	tests := []struct {
+		name     string
+		strategy SliceStrategy
+		wantTags []any
+	}{
+		{"替换", SliceReplace, []any{"b", "c"}},
+		{"追加", SliceAppend, []any{"a", "b", "b", "c"}},
+		{"并集", SliceUnion, []any{"a", "b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DeepMerge(base, override, WithSliceStrategy(tt.strategy))
+			want := map[string]any{
+				"name": "svc",
+				"db":   map[string]any{"host": "localhost", "port": 6432, "user": "app"},
+				"tags": tt.wantTags,
+				"list": "scalar",
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("DeepMerge() = %v, 期望 %v", got, want)
+			}
+		})
+	}
+
+	t.Run("不修改输入", func(t *testing.T) {
+		merged := DeepMerge(base, override, WithSliceStrategy(SliceAppend))
+		merged["db"].(map[string]any)["host"] = "changed"
+		if base["db"].(map[string]any)["host"] != "localhost" || len(base["tags"].([]any)) != 2 {
+			t.Errorf("base 被修改: %v", base)
+		}
+		if _, ok := base["db"].(map[string]any)["user"]; ok {
+			t.Errorf("base 被修改: %v", base)
+		}
+	})
+
+	t.Run("nil 输入", func(t *testing.T) {
+		if got := DeepMerge(nil, map[string]any{"a": 1}); got["a"] != 1 {
+			t.Errorf("DeepMerge(nil) = %v", got)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package many
+
+import (
+	"testing"
+
+	"github.com/lwmacct/250300-go-mod-many/internal/benchmatrix"
+)
+
+func BenchmarkMatrix(b *testing.B) {
+	benchmatrix.Run(b, []benchmatrix.Target{
+		benchmatrix.NewTarget("bool", To[bool]),
+		benchmatrix.NewTarget("string", To[string]),
+		benchmatrix.NewTarget("int", To[int]),
+		benchmatrix.NewTarget("int8", To[int8]),
+		benchmatrix.NewTarget("int16", To[int16]),
+		benchmatrix.NewTarget("int32", To[int32]),
+		benchmatrix.NewTarget("int64", To[int64]),
+		benchmatrix.NewTarget("uint", To[uint]),
+		benchmatrix.NewTarget("uint8", To[uint8]),
+		benchmatrix.NewTarget("uint16", To[uint16]),
+		benchmatrix.NewTarget("uint32", To[uint32]),
+		benchmatrix.NewTarget("uint64", To[uint64]),
+		benchmatrix.NewTarget("float32", To[float32]),
+		benchmatrix.NewTarget("float64", To[float64]),
+		benchmatrix.NewTarget("complex128", To[complex128]),
+		benchmatrix.NewTarget("bytes", To[[]byte]),
+	})
+}
@@ -0,0 +1,201 @@
+package many
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+)
+
+// convertStd 处理标准库中的网络、URL 与正则类型以及 UUID/ULID, ok 表示 T 是否为这些类型之一
+// 支持的目标类型: netip.Addr, netip.Prefix, netip.AddrPort, net.IP, *net.IPNet, *url.URL, *regexp.Regexp, UUID, ULID
+func convertStd[T any](v any) (result T, ok bool, err error) {
+	switch p := any(&result).(type) {
+	case *netip.Addr:
+		*p, err = toAddrE(v)
+	case *netip.Prefix:
+		*p, err = toPrefixE(v)
+	case *netip.AddrPort:
+		*p, err = toAddrPortE(v)
+	case *net.IP:
+		*p, err = toIPE(v)
+	case **net.IPNet:
+		*p, err = toIPNetE(v)
+	case **url.URL:
+		*p, err = toURLE(v)
+	case **regexp.Regexp:
+		*p, err = toRegexpE(v)
+	case *UUID:
+		*p, err = toUUIDE(v)
+	case *ULID:
+		*p, err = toULIDE(v)
+	default:
+		return result, false, nil
+	}
+	if err != nil {
+		var zero T
+		return zero, true, err
+	}
+	return result, true, nil
+}
+
+// textOf 取出字符串类源值的文本
+func textOf(v any) (string, bool) {
+	switch val := v.(type) {
+	case string:
+		return val, true
+	case []byte:
+		return string(val), true
+	case json.Number:
+		return string(val), true
+	default:
+		return "", false
+	}
+}
+
+// toAddrE 将字符串或 IPv4 整数转换为 netip.Addr
+func toAddrE(v any) (netip.Addr, error) {
+	if v == nil {
+		return netip.Addr{}, nil
+	}
+	if s, ok := textOf(v); ok {
+		return netip.ParseAddr(s)
+	}
+
+	switch val := v.(type) {
+	case netip.Addr:
+		return val, nil
+	case net.IP:
+		if addr, ok := netip.AddrFromSlice(val); ok {
+			return addr.Unmap(), nil
+		}
+		return netip.Addr{}, fmt.Errorf("invalid IP length %d", len(val))
+	case [4]byte:
+		return netip.AddrFrom4(val), nil
+	case [16]byte:
+		return netip.AddrFrom16(val).Unmap(), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		n := toInt64(val)
+		if n < 0 || n > math.MaxUint32 {
+			return netip.Addr{}, fmt.Errorf("integer %v out of IPv4 range", val)
+		}
+		var b [4]byte
+		binary.BigEndian.PutUint32(b[:], uint32(n))
+		return netip.AddrFrom4(b), nil
+	default:
+		return netip.Addr{}, fmt.Errorf("cannot convert %T to netip.Addr", v)
+	}
+}
+
+// toPrefixE 将 CIDR 字符串转换为 netip.Prefix
+func toPrefixE(v any) (netip.Prefix, error) {
+	if v == nil {
+		return netip.Prefix{}, nil
+	}
+	if s, ok := textOf(v); ok {
+		return netip.ParsePrefix(s)
+	}
+
+	switch val := v.(type) {
+	case netip.Prefix:
+		return val, nil
+	case *net.IPNet:
+		addr, ok := netip.AddrFromSlice(val.IP)
+		if !ok {
+			return netip.Prefix{}, fmt.Errorf("invalid IP length %d", len(val.IP))
+		}
+		bits, _ := val.Mask.Size()
+		return netip.PrefixFrom(addr.Unmap(), bits), nil
+	default:
+		return netip.Prefix{}, fmt.Errorf("cannot convert %T to netip.Prefix", v)
+	}
+}
+
+// toAddrPortE 将 host:port 字符串转换为 netip.AddrPort
+func toAddrPortE(v any) (netip.AddrPort, error) {
+	if v == nil {
+		return netip.AddrPort{}, nil
+	}
+	if s, ok := textOf(v); ok {
+		return netip.ParseAddrPort(s)
+	}
+
+	switch val := v.(type) {
+	case netip.AddrPort:
+		return val, nil
+	default:
+		return netip.AddrPort{}, fmt.Errorf("cannot convert %T to netip.AddrPort", v)
+	}
+}
+
+// toIPE 将字符串或 IPv4 整数转换为 net.IP
+func toIPE(v any) (net.IP, error) {
+	if ip, ok := v.(net.IP); ok {
+		return ip, nil
+	}
+	addr, err := toAddrE(v)
+	if err != nil || !addr.IsValid() {
+		return nil, err
+	}
+	return net.IP(addr.AsSlice()), nil
+}
+
+// toIPNetE 将 CIDR 字符串转换为 *net.IPNet
+func toIPNetE(v any) (*net.IPNet, error) {
+	if n, ok := v.(*net.IPNet); ok {
+		return n, nil
+	}
+	if s, ok := textOf(v); ok {
+		_, n, err := net.ParseCIDR(s)
+		return n, err
+	}
+	prefix, err := toPrefixE(v)
+	if err != nil || !prefix.IsValid() {
+		return nil, err
+	}
+	prefix = prefix.Masked()
+	return &net.IPNet{
+		IP:   net.IP(prefix.Addr().AsSlice()),
+		Mask: net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen()),
+	}, nil
+}
+
+// toURLE 将字符串转换为 *url.URL
+func toURLE(v any) (*url.URL, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if s, ok := textOf(v); ok {
+		return url.Parse(s)
+	}
+
+	switch val := v.(type) {
+	case *url.URL:
+		return val, nil
+	case url.URL:
+		return &val, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to *url.URL", v)
+	}
+}
+
+// toRegexpE 将字符串编译为 *regexp.Regexp
+func toRegexpE(v any) (*regexp.Regexp, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if s, ok := textOf(v); ok {
+		return regexp.Compile(s)
+	}
+
+	switch val := v.(type) {
+	case *regexp.Regexp:
+		return val, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to *regexp.Regexp", v)
+	}
+}
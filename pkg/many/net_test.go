@@ -0,0 +1,79 @@
+package many
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"testing"
+)
+
+func TestToNetipTypes(t *testing.T) {
+	if got := To[netip.Addr](uint32(0xC0A80101)); got != netip.MustParseAddr("192.168.1.1") {
+		t.Errorf("To[netip.Addr](0xC0A80101) = %v, 期望 192.168.1.1", got)
+	}
+	if got := To[netip.Addr]("::1"); got != netip.IPv6Loopback() {
+		t.Errorf("To[netip.Addr](\"::1\") = %v, 期望 ::1", got)
+	}
+	if got := To[netip.Prefix]("10.0.0.0/8"); got != netip.MustParsePrefix("10.0.0.0/8") {
+		t.Errorf("To[netip.Prefix] = %v, 期望 10.0.0.0/8", got)
+	}
+	if got := To[netip.AddrPort]("127.0.0.1:8080"); got != netip.MustParseAddrPort("127.0.0.1:8080") {
+		t.Errorf("To[netip.AddrPort] = %v, 期望 127.0.0.1:8080", got)
+	}
+
+	if _, err := ToE[netip.Addr](-1); err == nil {
+		t.Errorf("ToE[netip.Addr](-1) 期望返回错误")
+	}
+	if _, err := ToE[netip.Addr](int64(1) << 33); err == nil {
+		t.Errorf("ToE[netip.Addr](1<<33) 期望返回错误")
+	}
+	if _, err := ToE[netip.Prefix]("10.0.0.0"); err == nil {
+		t.Errorf("ToE[netip.Prefix](\"10.0.0.0\") 期望返回错误")
+	}
+	if _, err := ToE[netip.AddrPort]("127.0.0.1"); err == nil {
+		t.Errorf("ToE[netip.AddrPort](\"127.0.0.1\") 期望返回错误")
+	}
+}
+
+func TestToNetTypes(t *testing.T) {
+	if got := To[net.IP](167772161); !got.Equal(net.IPv4(10, 0, 0, 1)) {
+		t.Errorf("To[net.IP](167772161) = %v, 期望 10.0.0.1", got)
+	}
+
+	ipnet := To[*net.IPNet]("192.168.1.7/24")
+	if ipnet == nil || ipnet.String() != "192.168.1.0/24" {
+		t.Errorf("To[*net.IPNet] = %v, 期望 192.168.1.0/24", ipnet)
+	}
+	if n, err := ToE[*net.IPNet](netip.MustParsePrefix("fd00::1/64")); err != nil || n.String() != "fd00::/64" {
+		t.Errorf("ToE[*net.IPNet](netip.Prefix) = %v, %v, 期望 fd00::/64", n, err)
+	}
+	if _, err := ToE[*net.IPNet]("bad"); err == nil {
+		t.Errorf("ToE[*net.IPNet](\"bad\") 期望返回错误")
+	}
+
+	if p := To[netip.Prefix](ipnet); p != netip.MustParsePrefix("192.168.1.0/24") {
+		t.Errorf("To[netip.Prefix](*net.IPNet) = %v, 期望 192.168.1.0/24", p)
+	}
+}
+
+func TestToURLAndRegexp(t *testing.T) {
+	u := To[*url.URL]("https://example.com:8443/path?q=1")
+	if u == nil || u.Host != "example.com:8443" || u.Path != "/path" {
+		t.Errorf("To[*url.URL] = %v", u)
+	}
+	if _, err := ToE[*url.URL]("http://[::1"); err == nil {
+		t.Errorf("ToE[*url.URL] 期望返回解析错误")
+	}
+	if got := To[string](u); got != "https://example.com:8443/path?q=1" {
+		t.Errorf("To[string](*url.URL) = %q", got)
+	}
+
+	re := To[*regexp.Regexp](`^a+b$`)
+	if re == nil || !re.MatchString("aaab") {
+		t.Errorf("To[*regexp.Regexp] = %v", re)
+	}
+	if _, err := ToE[*regexp.Regexp]("("); err == nil {
+		t.Errorf("ToE[*regexp.Regexp](\"(\") 期望返回编译错误")
+	}
+}
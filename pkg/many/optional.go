@@ -0,0 +1,252 @@
+package many
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Optional 表示可能不存在的值, 区分三种状态: 有值 (Some), 缺失 (None, 零值) 与显式的 null (Null)
+// 作为 JSON 字段时, 缺失的字段保持 None, null 解析为 Null, 配合 `json:",omitzero"` 可在序列化时省略 None
+type Optional[T any] struct {
+	value T
+	valid bool
+	null  bool
+}
+
+// Some 返回持有 v 的 Optional
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, valid: true}
+}
+
+// None 返回缺失的 Optional, 与零值相同
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Null 返回显式为 null 的 Optional
+func Null[T any]() Optional[T] {
+	return Optional[T]{null: true}
+}
+
+// OptionalFromPtr p 为 nil 时返回 None, 否则返回 Some(*p)
+func OptionalFromPtr[T any](p *T) Optional[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// Get 返回持有的值, ok 表示是否有值
+func (o Optional[T]) Get() (value T, ok bool) {
+	return o.value, o.valid
+}
+
+// IsSome 报告是否有值
+func (o Optional[T]) IsSome() bool {
+	return o.valid
+}
+
+// IsNone 报告是否没有值, 缺失与 null 都算
+func (o Optional[T]) IsNone() bool {
+	return !o.valid
+}
+
+// IsNull 报告是否为显式的 null
+func (o Optional[T]) IsNull() bool {
+	return o.null
+}
+
+// IsZero 报告是否缺失, 供 encoding/json 的 omitzero 使用
+func (o Optional[T]) IsZero() bool {
+	return !o.valid && !o.null
+}
+
+// OrElse 有值时返回该值, 否则返回 def
+func (o Optional[T]) OrElse(def T) T {
+	if o.valid {
+		return o.value
+	}
+	return def
+}
+
+// OrElseGet 与 OrElse 相同, 但仅在没有值时调用 fn
+func (o Optional[T]) OrElseGet(fn func() T) T {
+	if o.valid {
+		return o.value
+	}
+	return fn()
+}
+
+// Map 有值时返回 Some(fn(value)), 否则原样返回, 转换为其他类型使用 MapOptional
+func (o Optional[T]) Map(fn func(T) T) Optional[T] {
+	if o.valid {
+		return Some(fn(o.value))
+	}
+	return o
+}
+
+// Ptr 有值时返回指向值副本的指针, 否则返回 nil
+func (o Optional[T]) Ptr() *T {
+	if o.valid {
+		v := o.value
+		return &v
+	}
+	return nil
+}
+
+// MapOptional 有值时返回 Some(fn(value)), 否则返回同样状态 (None 或 Null) 的 Optional[U]
+// Go 的方法不能带类型参数, 因此以函数形式提供
+func MapOptional[T, U any](o Optional[T], fn func(T) U) Optional[U] {
+	if o.valid {
+		return Some(fn(o.value))
+	}
+	return Optional[U]{null: o.null}
+}
+
+// MarshalJSON 实现 json.Marshaler, None 与 Null 都序列化为 null
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler, null 解析为 Null
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// Scan 实现 sql.Scanner, NULL 解析为 Null
+// *T 实现了 sql.Scanner 时交由其处理, 否则按与 database/sql 相近的规则转换:
+// 零值合法, 越界与整数目标的小数部分是错误, 浮点目标按最近值舍入 (如 float64 0.1 写入 float32)
+func (o *Optional[T]) Scan(src any) error {
+	if src == nil {
+		*o = Null[T]()
+		return nil
+	}
+	var v T
+	if scanner, ok := any(&v).(sql.Scanner); ok {
+		if err := scanner.Scan(src); err != nil {
+			return err
+		}
+		*o = Some(v)
+		return nil
+	}
+	// 驱动常以 []byte 返回数值列, 且会复用底层内存, 按字符串处理同时完成复制
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
+	d := dispatcherOf[T]()
+	if scanLoses(d, src) {
+		return fmt.Errorf("scan into Optional[%T]: %w", v, lossError[T](src))
+	}
+	v, err := d.exactE(src)
+	if err != nil {
+		return fmt.Errorf("scan into Optional[%T]: %w", v, err)
+	}
+	*o = Some(v)
+	return nil
+}
+
+// scanLoses 判断 Scan 是否拒绝 src: 浮点与复数目标按最近值舍入, 不视为丢失,
+// 其他目标沿用严格模式的规则, 如 1.5 不能写入整数
+func scanLoses[T any](d *dispatcher[T], src any) bool {
+	switch any((*T)(nil)).(type) {
+	case *float32, *float64, *complex64, *complex128:
+		return false
+	}
+	return d.loses != nil && d.loses(src)
+}
+
+// Value 实现 driver.Valuer, 没有值时返回 NULL
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// newDispatcher 实现 dispatcherProvider, nil 与 nil 指针得到 None, 其余输入包装 T 的转换结果:
+// To[Optional[T]] 总是得到 Some(To[T](v)), 如 To[Optional[bool]]("abc") 为 Some(false),
+// ToE[Optional[T]] 在 ToE[T] 返回错误时得到 None 与该错误,
+// ProfileStrict 与 ProfileLenient 按各自的规则转换, 零值结果同样是 Some,
+// ProfileLegacy 的 ConvertTo/ConvertToE 包装冻结的 legacy 转换
+func (*Optional[T]) newDispatcher(legacy bool) any {
+	inner := dispatcherOf[T]()
+	convert, convertE := inner.convert, inner.convertE
+	if legacy {
+		l := legacyDispatcherOf[T]()
+		convert, convertE = l.convert, l.convertE
+	}
+	wrapE := func(conv func(any) (T, error)) func(any) (Optional[T], error) {
+		return func(v any) (Optional[T], error) {
+			if o, ok := optionalOf[T](v); ok {
+				return o, nil
+			}
+			result, err := conv(v)
+			if err != nil {
+				return None[T](), err
+			}
+			return Some(result), nil
+		}
+	}
+	return &dispatcher[Optional[T]]{
+		convert: func(v any) Optional[T] {
+			if o, ok := optionalOf[T](v); ok {
+				return o
+			}
+			return Some(convert(v))
+		},
+		convertE:  wrapE(convertE),
+		exactE:    wrapE(inner.exactE),
+		normalize: inner.normalize,
+		loses: func(v any) bool {
+			switch v.(type) {
+			case nil, Optional[T], *T:
+				return false
+			}
+			return inner.loses != nil && inner.loses(v)
+		},
+	}
+}
+
+// explainTarget 实现 targetExplainer, 与 newDispatcher 的分支一致
+func (*Optional[T]) explainTarget(tr *Trace, v any) {
+	if _, ok := optionalOf[T](v); ok {
+		tr.add(StepTarget, "nil, Optional[%[1]s] and *%[1]s are used directly", reflect.TypeFor[T]())
+		return
+	}
+	tr.add(StepTarget, "wraps the %s conversion", reflect.TypeFor[T]())
+	explainTarget[T](tr, v)
+}
+
+// unwrap 返回 Optional 保存的值, 供 Explain 判断内层结果
+func (o Optional[T]) unwrap() any {
+	return o.value
+}
+
+// optionalOf 处理无需经过 T 转换的输入: nil, Optional[T] 与 *T
+func optionalOf[T any](v any) (Optional[T], bool) {
+	switch val := v.(type) {
+	case nil:
+		return None[T](), true
+	case Optional[T]:
+		return val, true
+	case *T:
+		return OptionalFromPtr(val), true
+	}
+	return Optional[T]{}, false
+}
@@ -0,0 +1,226 @@
+package many
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestOptional(t *testing.T) {
+	some := Some(3)
+	if v, ok := some.Get(); !ok || v != 3 {
+		t.Errorf("Some(3).Get() = %d, %v", v, ok)
+	}
+	if !some.IsSome() || some.IsNone() || some.IsNull() || some.IsZero() {
+		t.Errorf("Some(3) 状态错误: %+v", some)
+	}
+
+	none := None[int]()
+	if none.IsSome() || !none.IsNone() || none.IsNull() || !none.IsZero() {
+		t.Errorf("None 状态错误: %+v", none)
+	}
+	null := Null[int]()
+	if null.IsSome() || !null.IsNone() || !null.IsNull() || null.IsZero() {
+		t.Errorf("Null 状态错误: %+v", null)
+	}
+
+	if got := none.OrElse(9); got != 9 {
+		t.Errorf("None.OrElse(9) = %d", got)
+	}
+	if got := some.OrElseGet(func() int { t.Error("有值时不应调用 fn"); return 9 }); got != 3 {
+		t.Errorf("Some(3).OrElseGet() = %d", got)
+	}
+	if got := some.Map(func(v int) int { return v * 2 }).OrElse(0); got != 6 {
+		t.Errorf("Some(3).Map() = %d", got)
+	}
+	if got := MapOptional(some, func(v int) string { return To[string](v) }); got.OrElse("") != "3" {
+		t.Errorf("MapOptional(Some(3)) = %+v", got)
+	}
+	if got := MapOptional(null, func(v int) string { return "" }); !got.IsNull() {
+		t.Errorf("MapOptional(Null) = %+v, 应保持 Null", got)
+	}
+	if p := some.Ptr(); p == nil || *p != 3 {
+		t.Errorf("Some(3).Ptr() = %v", p)
+	}
+	if p := none.Ptr(); p != nil {
+		t.Errorf("None.Ptr() = %v", p)
+	}
+	if o := OptionalFromPtr[int](nil); !o.IsZero() {
+		t.Errorf("OptionalFromPtr(nil) = %+v", o)
+	}
+}
+
+func TestOptionalJSON(t *testing.T) {
+	type patch struct {
+		Name Optional[string] `json:"name,omitzero"`
+		Age  Optional[int]    `json:"age,omitzero"`
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		wantName Optional[string]
+		wantAge  Optional[int]
+		output   string
+	}{
+		{"字段缺失", `{}`, None[string](), None[int](), `{}`},
+		{"显式 null", `{"name":null}`, Null[string](), None[int](), `{"name":null}`},
+		{"有值", `{"name":"a","age":0}`, Some("a"), Some(0), `{"name":"a","age":0}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p patch
+			if err := json.Unmarshal([]byte(tt.input), &p); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if p.Name != tt.wantName || p.Age != tt.wantAge {
+				t.Errorf("Unmarshal(%s) = %+v", tt.input, p)
+			}
+			data, err := json.Marshal(p)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(data) != tt.output {
+				t.Errorf("Marshal() = %s, 期望 %s", data, tt.output)
+			}
+		})
+	}
+
+	var o Optional[int]
+	if err := json.Unmarshal([]byte(`"x"`), &o); err == nil {
+		t.Error(`Unmarshal("x") 应返回错误`)
+	}
+}
+
+// upperString 实现 sql.Scanner, 用于验证 Optional 交由 *T 处理
+type upperString string
+
+func (u *upperString) Scan(src any) error {
+	*u = upperString("<" + To[string](src) + ">")
+	return nil
+}
+
+func TestOptionalSQL(t *testing.T) {
+	var _ sql.Scanner = (*Optional[int])(nil)
+	var _ driver.Valuer = Optional[int]{}
+
+	tests := []struct {
+		name    string
+		src     any
+		want    Optional[int64]
+		wantErr bool
+	}{
+		{"NULL", nil, Null[int64](), false},
+		{"整数", int64(7), Some(int64(7)), false},
+		{"零", int64(0), Some(int64(0)), false},
+		{"字节", []byte("42"), Some(int64(42)), false},
+		{"小数", 1.5, None[int64](), true},
+		{"无法解析", "x", None[int64](), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var o Optional[int64]
+			err := o.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%#v) error = %v, wantErr %v", tt.src, err, tt.wantErr)
+			}
+			if o != tt.want {
+				t.Errorf("Scan(%#v) = %+v, 期望 %+v", tt.src, o, tt.want)
+			}
+		})
+	}
+
+	floats := []struct {
+		name    string
+		src     any
+		want    Optional[float32]
+		wantErr bool
+	}{
+		{"float64 舍入为 float32", 0.1, Some(float32(0.1)), false},
+		{"字节形式的小数", []byte("0.1"), Some(float32(0.1)), false},
+		{"零", 0.0, Some(float32(0)), false},
+		{"超出 float32 范围", 1e300, None[float32](), true},
+	}
+	for _, tt := range floats {
+		t.Run(tt.name, func(t *testing.T) {
+			var o Optional[float32]
+			err := o.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%#v) error = %v, wantErr %v", tt.src, err, tt.wantErr)
+			}
+			if o != tt.want {
+				t.Errorf("Scan(%#v) = %+v, 期望 %+v", tt.src, o, tt.want)
+			}
+		})
+	}
+
+	var u Optional[upperString]
+	if err := u.Scan("a"); err != nil || u.OrElse("") != "<a>" {
+		t.Errorf("Scan() 应调用 *T 的 Scan, 得到 %+v, %v", u, err)
+	}
+
+	if v, err := Some(int32(5)).Value(); err != nil || v != int64(5) {
+		t.Errorf("Some(5).Value() = %#v, %v", v, err)
+	}
+	if v, err := None[string]().Value(); err != nil || v != nil {
+		t.Errorf("None.Value() = %#v, %v", v, err)
+	}
+}
+
+func TestToOptional(t *testing.T) {
+	seven := 7
+	tests := []struct {
+		name    string
+		v       any
+		want    Optional[int] // To 的结果
+		wantE   Optional[int] // ToE 的结果
+		wantErr bool
+	}{
+		{"nil", nil, None[int](), None[int](), false},
+		{"字符串", "12", Some(12), Some(12), false},
+		{"零值与 ToE[int] 一致", "0", Some(0), None[int](), true},
+		{"无法转换", "abc", Some(0), None[int](), true},
+		{"越界", "1e30", Some(math.MaxInt), None[int](), true},
+		{"指针", &seven, Some(7), Some(7), false},
+		{"nil 指针", (*int)(nil), None[int](), None[int](), false},
+		{"Optional", Null[int](), Null[int](), Null[int](), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToE[Optional[int]](tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToE() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.wantE {
+				t.Errorf("ToE[Optional[int]](%#v) = %+v, 期望 %+v", tt.v, got, tt.wantE)
+			}
+			if plain := To[Optional[int]](tt.v); plain != tt.want {
+				t.Errorf("To[Optional[int]](%#v) = %+v, 期望 %+v", tt.v, plain, tt.want)
+			}
+		})
+	}
+
+	// To[Optional[T]] 与 To[T] 的规则一致
+	for _, v := range []any{"abc", "yes", 2, 1.0} {
+		if got, want := To[Optional[bool]](v), To[bool](v); got != Some(want) {
+			t.Errorf("To[Optional[bool]](%#v) = %+v, To[bool] = %v", v, got, want)
+		}
+	}
+
+	// 严格与宽松配置档仍按各自的规则转换, 零值是 Some
+	if got, err := StrictToE[Optional[int]]("0"); err != nil || got != Some(0) {
+		t.Errorf(`StrictToE[Optional[int]]("0") = %+v, %v`, got, err)
+	}
+	if got, err := StrictToE[Optional[bool]]("abc"); err == nil || got != None[bool]() {
+		t.Errorf(`StrictToE[Optional[bool]]("abc") = %+v, %v`, got, err)
+	}
+
+	if got, err := StrictToE[Optional[string]](Some("x")); err != nil || got.OrElse("") != "x" {
+		t.Errorf("StrictToE[Optional[string]](Some) = %+v, %v", got, err)
+	}
+	if got := ConvertTo[Optional[int]](NewConverter(WithProfile(ProfileLenient)), " 0x10 "); got.OrElse(0) != 16 {
+		t.Errorf("lenient To[Optional[int]] = %+v", got)
+	}
+}
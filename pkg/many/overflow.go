@@ -0,0 +1,137 @@
+package many
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+)
+
+// rangeCheck 判断源值的数值是否超出目标类型的范围, 供 ToE 拒绝溢出后被截断或回绕的结果
+type rangeCheck interface {
+	overflows(v any) bool
+	overflowsString(s string) bool
+}
+
+// intRange 整数目标类型的取值范围
+type intRange struct {
+	lo int64
+	hi uint64
+}
+
+func (r intRange) overflows(v any) bool {
+	switch val := v.(type) {
+	case int:
+		return r.overflowsInt(int64(val))
+	case int8:
+		return r.overflowsInt(int64(val))
+	case int16:
+		return r.overflowsInt(int64(val))
+	case int32:
+		return r.overflowsInt(int64(val))
+	case int64:
+		return r.overflowsInt(val)
+	case uint:
+		return uint64(val) > r.hi
+	case uint8:
+		return uint64(val) > r.hi
+	case uint16:
+		return uint64(val) > r.hi
+	case uint32:
+		return uint64(val) > r.hi
+	case uint64:
+		return val > r.hi
+	case float32:
+		return r.overflowsFloat(float64(val))
+	case float64:
+		return r.overflowsFloat(val)
+	case complex64, complex128:
+		if f, ok := realPart(val); ok {
+			return r.overflowsFloat(f)
+		}
+		return false
+	case string:
+		return r.overflowsString(val)
+	case json.Number:
+		return r.overflowsString(string(val))
+	default:
+		return false
+	}
+}
+
+// overflowsString 按 toInt64/toUint64 的解析顺序判断字符串的数值是否越界, 无法解析时返回 false
+func (r intRange) overflowsString(s string) bool {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return r.overflowsInt(i)
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return u > r.hi
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil && f == 0 {
+		return false
+	}
+	// 超出 float64 范围时 ParseFloat 返回 ±Inf 与 ErrRange
+	return r.overflowsFloat(f)
+}
+
+func (r intRange) overflowsInt(i int64) bool {
+	if i < 0 {
+		return i < r.lo
+	}
+	return uint64(i) > r.hi
+}
+
+func (r intRange) overflowsFloat(f float64) bool {
+	if math.IsNaN(f) {
+		return true
+	}
+	f = math.Trunc(f)
+	// float64(r.hi)+1 可能因精度丢失等于 float64(r.hi), 此时恰好是 2 的幂, 比较仍然正确
+	return f < float64(r.lo) || f >= float64(r.hi)+1
+}
+
+// floatRange 浮点目标类型的范围, 有限的源值转换后变为 ±Inf 视为溢出
+type floatRange struct {
+	bitSize int
+}
+
+func (r floatRange) overflows(v any) bool {
+	switch val := v.(type) {
+	case string:
+		return r.overflowsString(val)
+	case json.Number:
+		return r.overflowsString(string(val))
+	case float64, complex128:
+		return r.overflowsFloat(toFloat64(val))
+	default:
+		return false
+	}
+}
+
+// overflowsString 超出 float64 范围的字符串 (ParseFloat 返回 ErrRange) 同样视为溢出
+func (r floatRange) overflowsString(s string) bool {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return errors.Is(err, strconv.ErrRange) && math.IsInf(f, 0)
+	}
+	return r.overflowsFloat(f)
+}
+
+func (r floatRange) overflowsFloat(f float64) bool {
+	return r.bitSize == 32 && !math.IsInf(f, 0) && math.IsInf(float64(float32(f)), 0)
+}
+
+// 各整数目标类型的范围
+var (
+	intRangeInt    = intRange{math.MinInt, math.MaxInt}
+	intRangeInt8   = intRange{math.MinInt8, math.MaxInt8}
+	intRangeInt16  = intRange{math.MinInt16, math.MaxInt16}
+	intRangeInt32  = intRange{math.MinInt32, math.MaxInt32}
+	intRangeInt64  = intRange{math.MinInt64, math.MaxInt64}
+	intRangeUint   = intRange{0, math.MaxUint}
+	intRangeUint8  = intRange{0, math.MaxUint8}
+	intRangeUint16 = intRange{0, math.MaxUint16}
+	intRangeUint32 = intRange{0, math.MaxUint32}
+	intRangeUint64 = intRange{0, math.MaxUint64}
+)
@@ -0,0 +1,259 @@
+package many
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Profile 转换行为的配置档, 每个配置档的语义在模块的主版本内保持不变,
+// 升级模块不会改变已有数据在同一配置档下的解释方式
+type Profile int
+
+const (
+	// ProfileLegacy 冻结引入配置档之前 To/ToE 的行为: 无法转换时返回零值, ToE 在非 nil 输入得到零值时返回错误,
+	// 整数越界时回绕且不返回错误, 带小数的浮点数格式化为两位小数, 之后 To/ToE 的修正不影响该配置档
+	ProfileLegacy Profile = iota
+	// ProfileStrict 仅接受能精确表示为目标类型的输入, "0" 转 int 等零值结果不是错误,
+	// 无法解析的字符串, 不支持的类型, 越界的数值, 丢失信息的转换 (见 WithStrict) 以及 nil 均返回错误
+	ProfileStrict
+	// ProfileLenient 在 ProfileStrict 的基础上接受常见的宽松写法:
+	// 字符串去除首尾空白, 整数支持 0x/0o/0b 前缀与下划线分隔, bool 不区分大小写且非零数值为 true,
+	// nil 转换为零值且不返回错误
+	ProfileLenient
+)
+
+// profileNames 配置档的文本形式, 用于配置文件与日志
+var profileNames = [...]string{
+	ProfileLegacy:  "legacy",
+	ProfileStrict:  "strict",
+	ProfileLenient: "lenient",
+}
+
+// String 返回配置档的名称, 如 "strict"
+func (p Profile) String() string {
+	if p >= 0 && int(p) < len(profileNames) {
+		return profileNames[p]
+	}
+	return "Profile(" + strconv.Itoa(int(p)) + ")"
+}
+
+// ParseProfile 解析配置档名称, 不区分大小写
+func ParseProfile(s string) (Profile, error) {
+	for p, name := range profileNames {
+		if strings.EqualFold(s, name) {
+			return Profile(p), nil
+		}
+	}
+	return ProfileLegacy, fmt.Errorf("unknown profile %q", s)
+}
+
+// MarshalText 实现 encoding.TextMarshaler
+func (p Profile) MarshalText() ([]byte, error) {
+	if p < 0 || int(p) >= len(profileNames) {
+		return nil, fmt.Errorf("unknown profile %d", int(p))
+	}
+	return []byte(profileNames[p]), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler
+func (p *Profile) UnmarshalText(data []byte) error {
+	parsed, err := ParseProfile(string(data))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// sourceKind 描述基础目标类型在 ProfileStrict 与 ProfileLenient 下接受的输入,
+// loses 供严格模式判断转换是否丢失信息, nil 表示不会丢失
+type sourceKind struct {
+	accepts   func(v any) bool
+	normalize func(v any) any
+	loses     func(v any) bool
+}
+
+var (
+	intSource        = sourceKind{acceptNumber, lenientNumber, losesFraction}
+	float32Source    = sourceKind{acceptNumber, lenientNumber, losesFloat32}
+	float64Source    = sourceKind{acceptNumber, lenientNumber, losesFloat64}
+	complex64Source  = sourceKind{acceptComplex, lenientNumber, losesComplex64}
+	complex128Source = sourceKind{acceptComplex, lenientNumber, nil}
+	boolSource       = sourceKind{acceptBool, lenientBool, nil}
+	stringSource     = sourceKind{acceptString, func(v any) any { return v }, losesString}
+)
+
+// acceptNumber 判断 v 能否解释为实数, 越界由 rangeCheck 另行判断
+func acceptNumber(v any) bool {
+	switch val := v.(type) {
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	case complex64, complex128:
+		_, ok := realPart(val)
+		return ok
+	case string:
+		return isNumber(val)
+	case json.Number:
+		return isNumber(string(val))
+	default:
+		return false
+	}
+}
+
+// isNumber 判断字符串是否为十进制数值, 超出 float64 范围的数值也算
+// strconv 接受 Go 字面量中的数字分隔符 "1_000", 严格规则下不算数值
+func isNumber(s string) bool {
+	if strings.Contains(s, "_") {
+		return false
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil || errors.Is(err, strconv.ErrRange)
+}
+
+// acceptComplex 判断 v 能否解释为复数
+func acceptComplex(v any) bool {
+	switch val := v.(type) {
+	case complex64, complex128:
+		return true
+	case string:
+		return isComplex(val)
+	case json.Number:
+		return isComplex(string(val))
+	default:
+		return acceptNumber(val)
+	}
+}
+
+// isComplex 判断字符串是否为复数, 与 isNumber 一样不接受数字分隔符
+func isComplex(s string) bool {
+	if strings.Contains(s, "_") {
+		return false
+	}
+	_, err := strconv.ParseComplex(s, 128)
+	return err == nil
+}
+
+// acceptBool 判断 v 能否解释为 bool, 数值只接受 0 和 1, 字符串只接受 parseBool 与 parseFalse 识别的写法
+func acceptBool(v any) bool {
+	switch val := v.(type) {
+	case bool:
+		return true
+	case string:
+		return parseBool(val) || parseFalse(val)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		f := toFloat64(val)
+		return f == 0 || f == 1
+	default:
+		return false
+	}
+}
+
+// acceptString 除 nil 外的输入都能格式化为字符串
+func acceptString(v any) bool {
+	return v != nil
+}
+
+// parseFalse 识别常见的假值写法, 与 parseBool 对应
+func parseFalse(s string) bool {
+	switch s {
+	case "0", "f", "F", "false", "FALSE", "False", "no", "NO", "No", "n", "N", "off", "OFF", "Off":
+		return true
+	default:
+		return false
+	}
+}
+
+// lenientText 去除字符串首尾的空白
+func lenientText(v any) any {
+	if s, ok := v.(string); ok {
+		return strings.TrimSpace(s)
+	}
+	return v
+}
+
+// lenientNumber 去除首尾空白, 带 0x/0o/0b 前缀的整数按对应进制解析, 去掉数字间的下划线
+// 下划线的位置与 Go 字面量的规则相同, "_1", "1_", "1__0" 保持原样, 由后续解析返回错误
+// 不带前缀的 "010" 仍按十进制解释, 与 ProfileLegacy 一致
+func lenientNumber(v any) any {
+	var s string
+	switch val := v.(type) {
+	case string:
+		s = val
+	case json.Number:
+		s = string(val)
+	default:
+		return v
+	}
+	s = strings.TrimSpace(s)
+	if hasBasePrefix(s) {
+		if i, err := strconv.ParseInt(s, 0, 64); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(s, 0, 64); err == nil {
+			return u
+		}
+		return s
+	}
+	if !digitSeparated(s) {
+		return s
+	}
+	return strings.ReplaceAll(s, "_", "")
+}
+
+// digitSeparated 判断 s 中的每个下划线是否都恰好位于两个十进制数字之间
+func digitSeparated(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] != '_' {
+			continue
+		}
+		if i == 0 || i == len(s)-1 || !isDigit(s[i-1]) || !isDigit(s[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// hasBasePrefix 判断整数字面量是否带有 0x/0o/0b 前缀, 允许前导符号
+func hasBasePrefix(s string) bool {
+	s = strings.TrimLeft(s, "+-")
+	if len(s) < 2 || s[0] != '0' {
+		return false
+	}
+	switch s[1] {
+	case 'x', 'X', 'o', 'O', 'b', 'B':
+		return true
+	default:
+		return false
+	}
+}
+
+// lenientBool 字符串不区分大小写并去除首尾空白, 数值非零即为 true
+func lenientBool(v any) any {
+	switch val := v.(type) {
+	case string:
+		s := strings.ToLower(strings.TrimSpace(val))
+		switch {
+		case parseBool(s):
+			return true
+		case parseFalse(s):
+			return false
+		}
+		return s
+	case json.Number:
+		if f, err := val.Float64(); err == nil {
+			return f != 0
+		}
+		return v
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return toFloat64(val) != 0
+	default:
+		return v
+	}
+}
@@ -0,0 +1,48 @@
+package many
+
+// Ptr 返回指向 v 副本的指针, 如 many.Ptr(3), many.Ptr("name")
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Deref 返回 p 指向的值, p 为 nil 时返回零值
+func Deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// DerefOr 返回 p 指向的值, p 为 nil 时返回 def
+func DerefOr[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// PtrTo 按 To 的规则将 v 转换为 T 后返回其指针, v 为 nil 时返回 nil
+func PtrTo[T any](v any) *T {
+	if v == nil {
+		return nil
+	}
+	return Ptr(To[T](v))
+}
+
+// PtrIfNonZero v 为零值时返回 nil, 否则返回指向 v 副本的指针, 常用于填充可省略的字段
+func PtrIfNonZero[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// EqualPtr 比较两个指针指向的值, 都为 nil 时相等, 只有一个为 nil 时不相等
+func EqualPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,65 @@
+package many
+
+import "testing"
+
+func TestPtr(t *testing.T) {
+	v := 3
+	p := Ptr(v)
+	if *p != 3 || p == &v {
+		t.Errorf("Ptr(3) = %v, 应指向副本", p)
+	}
+	if got := Deref(p); got != 3 {
+		t.Errorf("Deref() = %d", got)
+	}
+	if got := Deref[string](nil); got != "" {
+		t.Errorf("Deref(nil) = %q", got)
+	}
+	if got := DerefOr(nil, "guest"); got != "guest" {
+		t.Errorf("DerefOr(nil) = %q", got)
+	}
+	if got := DerefOr(Ptr(""), "guest"); got != "" {
+		t.Errorf(`DerefOr(Ptr("")) = %q, 非 nil 时应返回指向的值`, got)
+	}
+}
+
+func TestPtrTo(t *testing.T) {
+	if p := PtrTo[int]("42"); p == nil || *p != 42 {
+		t.Errorf(`PtrTo[int]("42") = %v`, p)
+	}
+	if p := PtrTo[int]("abc"); p == nil || *p != 0 {
+		t.Errorf(`PtrTo[int]("abc") = %v, 应与 To 一致返回零值的指针`, p)
+	}
+	if p := PtrTo[string](nil); p != nil {
+		t.Errorf("PtrTo(nil) = %v", p)
+	}
+}
+
+func TestPtrIfNonZero(t *testing.T) {
+	if p := PtrIfNonZero(""); p != nil {
+		t.Errorf(`PtrIfNonZero("") = %v`, p)
+	}
+	if p := PtrIfNonZero(1.5); p == nil || *p != 1.5 {
+		t.Errorf("PtrIfNonZero(1.5) = %v", p)
+	}
+}
+
+func TestEqualPtr(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *int
+		want bool
+	}{
+		{"都为 nil", nil, nil, true},
+		{"一个为 nil", Ptr(1), nil, false},
+		{"另一个为 nil", nil, Ptr(0), false},
+		{"值相等", Ptr(2), Ptr(2), true},
+		{"值不等", Ptr(2), Ptr(3), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualPtr(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualPtr() = %v, 期望 %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package many
+
+import "fmt"
+
+// Must err 不为 nil 时以 err panic, 否则返回 v, 如 port := many.Must(many.ToE[int](s))
+func Must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Try 调用 fn 并将其中的 panic 转换为错误, panic 的值为 error 时原样返回, 可用 errors.Is 判断
+func Try[T any](fn func() T) (result T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zero T
+			result = zero
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}
+	}()
+	return fn(), nil
+}
+
+// Result 保存一个值或一个错误, 用于串联多步转换与校验, 最后统一检查错误:
+//
+//	port, err := many.AndThenResult(many.ToResult[int](s), checkPort).Get()
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok 返回持有 v 的 Result
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err 返回持有 err 的 Result
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// ResultOf 将 (v, err) 形式的返回值包装为 Result, err 不为 nil 时丢弃 v
+func ResultOf[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// ToResult 按 ToE 的规则转换 v, 结果包装为 Result
+func ToResult[T any](v any) Result[T] {
+	return ResultOf(ToE[T](v))
+}
+
+// Get 返回值与错误
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// IsOk 报告是否没有错误
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Err 返回持有的错误
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap 返回持有的值, 有错误时以该错误 panic
+func (r Result[T]) Unwrap() T {
+	return Must(r.value, r.err)
+}
+
+// UnwrapOr 没有错误时返回持有的值, 否则返回 def
+func (r Result[T]) UnwrapOr(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
+
+// Map 没有错误时返回 Ok(fn(value)), 否则原样返回, 转换为其他类型使用 MapResult
+func (r Result[T]) Map(fn func(T) T) Result[T] {
+	if r.err != nil {
+		return r
+	}
+	return Ok(fn(r.value))
+}
+
+// AndThen 没有错误时调用 fn 并返回其结果, 否则原样返回且不调用 fn, 转换为其他类型使用 AndThenResult
+func (r Result[T]) AndThen(fn func(T) (T, error)) Result[T] {
+	if r.err != nil {
+		return r
+	}
+	return ResultOf(fn(r.value))
+}
+
+// MapResult 与 Result.Map 相同, 但 fn 可以返回其他类型
+// Go 的方法不能带类型参数, 因此以函数形式提供
+func MapResult[T, U any](r Result[T], fn func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(fn(r.value))
+}
+
+// AndThenResult 与 Result.AndThen 相同, 但 fn 可以返回其他类型
+func AndThenResult[T, U any](r Result[T], fn func(T) (U, error)) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return ResultOf(fn(r.value))
+}
@@ -0,0 +1,113 @@
+package many
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestMust(t *testing.T) {
+	if got := Must(ToE[int]("12")); got != 12 {
+		t.Errorf("Must() = %d", got)
+	}
+
+	_, err := Try(func() int { return Must(ToE[int]("abc")) })
+	if err == nil || err.Error() != "cannot convert string to int" {
+		t.Errorf("Must() 应以 ToE 的错误 panic, 得到 %v", err)
+	}
+}
+
+func TestTry(t *testing.T) {
+	errBoom := errors.New("boom")
+	tests := []struct {
+		name    string
+		fn      func() int
+		want    int
+		wantErr string
+	}{
+		{"正常返回", func() int { return 1 }, 1, ""},
+		{"panic error", func() int { panic(fmt.Errorf("wrap: %w", errBoom)) }, 0, "wrap: boom"},
+		{"panic 其他值", func() int { panic("oops") }, 0, "panic: oops"},
+		{"nil 指针", func() int { var p *int; return *p }, 0, "runtime error: invalid memory address or nil pointer dereference"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Try(tt.fn)
+			if got != tt.want {
+				t.Errorf("Try() = %d, 期望 %d", got, tt.want)
+			}
+			if gotErr := fmt.Sprint(err); (err != nil || tt.wantErr != "") && gotErr != tt.wantErr {
+				t.Errorf("Try() error = %v, 期望 %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := Try(func() int { panic(errBoom) }); !errors.Is(err, errBoom) {
+		t.Errorf("Try() error = %v, 应保留原始错误", err)
+	}
+}
+
+func TestResult(t *testing.T) {
+	errRange := errors.New("port out of range")
+	checkPort := func(p int) (int, error) {
+		if p < 1 || p > 65535 {
+			return 0, errRange
+		}
+		return p, nil
+	}
+
+	tests := []struct {
+		name    string
+		input   any
+		want    string
+		wantErr error
+	}{
+		{"成功", "8080", ":8080", nil},
+		{"转换失败", "x", "", nil},
+		{"校验失败", 70000, "", errRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := ToResult[int](tt.input).AndThen(checkPort)
+			addr, err := MapResult(r, func(p int) string { return ":" + To[string](p) }).Get()
+			if addr != tt.want {
+				t.Errorf("addr = %q, 期望 %q", addr, tt.want)
+			}
+			if (err == nil) != (tt.want != "") {
+				t.Errorf("err = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, 期望 %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("短路", func(t *testing.T) {
+		calls := 0
+		r := Err[int](errRange).
+			Map(func(v int) int { calls++; return v }).
+			AndThen(func(v int) (int, error) { calls++; return v, nil })
+		r2 := AndThenResult(r, func(v int) (string, error) { calls++; return "", nil })
+		if calls != 0 || !errors.Is(r2.Err(), errRange) {
+			t.Errorf("calls = %d, err = %v", calls, r2.Err())
+		}
+	})
+
+	t.Run("Unwrap", func(t *testing.T) {
+		if got := Ok(2).Map(func(v int) int { return v * 3 }).Unwrap(); got != 6 {
+			t.Errorf("Unwrap() = %d", got)
+		}
+		if got := Err[int](errRange).UnwrapOr(-1); got != -1 {
+			t.Errorf("UnwrapOr() = %d", got)
+		}
+		if _, err := Try(Err[int](errRange).Unwrap); !errors.Is(err, errRange) {
+			t.Errorf("Unwrap() 应以持有的错误 panic, 得到 %v", err)
+		}
+		if !Ok(0).IsOk() || Err[int](errRange).IsOk() {
+			t.Error("IsOk() 结果错误")
+		}
+		if r := ResultOf(5, errRange); r.IsOk() || r.UnwrapOr(0) != 0 {
+			t.Errorf("ResultOf(5, err) = %+v, 应丢弃值", r)
+		}
+	})
+}
@@ -0,0 +1,266 @@
+// Package slices 提供泛型切片工具函数, 元素类型转换委托给 many.To
+//
+// 回调可能失败的函数提供 E 结尾的变体, 在第一个错误处停止, 错误信息带有元素下标
+package slices
+
+import (
+	"fmt"
+
+	"github.com/lwmacct/250300-go-mod-many/pkg/many"
+)
+
+// Pair Zip 的结果元素
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Map 对每个元素调用 fn, 返回结果组成的切片
+func Map[S ~[]E, E, R any](s S, fn func(E) R) []R {
+	result := make([]R, len(s))
+	for i, v := range s {
+		result[i] = fn(v)
+	}
+	return result
+}
+
+// MapE 与 Map 相同, fn 返回错误时停止并返回该错误
+func MapE[S ~[]E, E, R any](s S, fn func(E) (R, error)) ([]R, error) {
+	result := make([]R, len(s))
+	for i, v := range s {
+		r, err := fn(v)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		result[i] = r
+	}
+	return result, nil
+}
+
+// Filter 返回 fn 为 true 的元素, 保持原有顺序
+func Filter[S ~[]E, E any](s S, fn func(E) bool) S {
+	result := make(S, 0, len(s))
+	for _, v := range s {
+		if fn(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// FilterE 与 Filter 相同, fn 返回错误时停止并返回该错误
+func FilterE[S ~[]E, E any](s S, fn func(E) (bool, error)) (S, error) {
+	result := make(S, 0, len(s))
+	for i, v := range s {
+		ok, err := fn(v)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		if ok {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// Reduce 从 init 开始依次用 fn 累积每个元素
+func Reduce[S ~[]E, E, A any](s S, init A, fn func(A, E) A) A {
+	acc := init
+	for _, v := range s {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// ReduceE 与 Reduce 相同, fn 返回错误时停止并返回该错误
+func ReduceE[S ~[]E, E, A any](s S, init A, fn func(A, E) (A, error)) (A, error) {
+	acc := init
+	for i, v := range s {
+		next, err := fn(acc, v)
+		if err != nil {
+			var zero A
+			return zero, indexError(i, err)
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
+// GroupBy 按 fn 返回的键分组, 组内保持原有顺序
+func GroupBy[S ~[]E, E any, K comparable](s S, fn func(E) K) map[K]S {
+	groups := make(map[K]S)
+	for _, v := range s {
+		k := fn(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// GroupByE 与 GroupBy 相同, fn 返回错误时停止并返回该错误
+func GroupByE[S ~[]E, E any, K comparable](s S, fn func(E) (K, error)) (map[K]S, error) {
+	groups := make(map[K]S)
+	for i, v := range s {
+		k, err := fn(v)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		groups[k] = append(groups[k], v)
+	}
+	return groups, nil
+}
+
+// Partition 将元素分为 fn 为 true 与 false 的两组
+func Partition[S ~[]E, E any](s S, fn func(E) bool) (matched, rest S) {
+	for _, v := range s {
+		if fn(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest
+}
+
+// PartitionE 与 Partition 相同, fn 返回错误时停止并返回该错误
+func PartitionE[S ~[]E, E any](s S, fn func(E) (bool, error)) (matched, rest S, err error) {
+	for i, v := range s {
+		ok, err := fn(v)
+		if err != nil {
+			return nil, nil, indexError(i, err)
+		}
+		if ok {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest, nil
+}
+
+// Chunk 将 s 按 size 个元素一组切分, 最后一组可能不足 size 个
+// 各组与 s 共用底层数组, 但容量被截断, 对某一组 append 不会覆盖下一组; size <= 0 时 panic
+func Chunk[S ~[]E, E any](s S, size int) []S {
+	if size <= 0 {
+		panic("slices: chunk size must be positive")
+	}
+	chunks := make([]S, 0, (len(s)+size-1)/size)
+	for start := 0; start < len(s); start += size {
+		end := min(start+size, len(s))
+		chunks = append(chunks, s[start:end:end])
+	}
+	return chunks
+}
+
+// Uniq 去除重复元素, 保留每个元素第一次出现的位置
+func Uniq[S ~[]E, E comparable](s S) S {
+	return UniqBy(s, func(v E) E { return v })
+}
+
+// UniqBy 按 fn 返回的键去重, 保留每个键第一次出现的元素
+func UniqBy[S ~[]E, E any, K comparable](s S, fn func(E) K) S {
+	seen := make(map[K]struct{}, len(s))
+	result := make(S, 0, len(s))
+	for _, v := range s {
+		k := fn(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// UniqByE 与 UniqBy 相同, fn 返回错误时停止并返回该错误
+func UniqByE[S ~[]E, E any, K comparable](s S, fn func(E) (K, error)) (S, error) {
+	seen := make(map[K]struct{}, len(s))
+	result := make(S, 0, len(s))
+	for i, v := range s {
+		k, err := fn(v)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+// Flatten 将二维切片按顺序拼接为一维
+func Flatten[S ~[]E, E any](s []S) S {
+	n := 0
+	for _, inner := range s {
+		n += len(inner)
+	}
+	result := make(S, 0, n)
+	for _, inner := range s {
+		result = append(result, inner...)
+	}
+	return result
+}
+
+// Zip 将 a, b 按下标配对, 长度取两者中较短的
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	n := min(len(a), len(b))
+	result := make([]Pair[A, B], n)
+	for i := range n {
+		result[i] = Pair[A, B]{a[i], b[i]}
+	}
+	return result
+}
+
+// Unzip 是 Zip 的逆操作
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
+
+// KeyBy 以 fn 返回的值为键建立索引, 键重复时保留最后一个元素
+func KeyBy[S ~[]E, E any, K comparable](s S, fn func(E) K) map[K]E {
+	result := make(map[K]E, len(s))
+	for _, v := range s {
+		result[fn(v)] = v
+	}
+	return result
+}
+
+// KeyByE 与 KeyBy 相同, fn 返回错误时停止并返回该错误
+func KeyByE[S ~[]E, E any, K comparable](s S, fn func(E) (K, error)) (map[K]E, error) {
+	result := make(map[K]E, len(s))
+	for i, v := range s {
+		k, err := fn(v)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+// ConvertAll 按 many.To 的规则转换每个元素, 如 ConvertAll[int]([]any{"1", 2.0})
+func ConvertAll[T any, S ~[]E, E any](s S) []T {
+	result := make([]T, len(s))
+	for i, v := range s {
+		result[i] = many.To[T](v)
+	}
+	return result
+}
+
+// ConvertAllE 按 many.ToE 的规则转换每个元素, 与其他 E 变体不同, 会转换全部元素,
+// 返回的错误汇总所有失败的下标, 见 many.BatchErrors
+func ConvertAllE[T any, S ~[]E, E any](s S) ([]T, error) {
+	result, errs := many.ConvertColumn[T](s)
+	return result, many.BatchErrors(errs)
+}
+
+// indexError 为回调返回的错误附加元素下标
+func indexError(i int, err error) error {
+	return fmt.Errorf("index %d: %w", i, err)
+}
@@ -0,0 +1,192 @@
+package slices
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/lwmacct/250300-go-mod-many/pkg/many"
+)
+
+var errOdd = errors.New("odd")
+
+// failOn 在遇到 bad 时返回 errOdd, 并记录调用次数
+func failOn(bad int, calls *int) func(int) (int, error) {
+	return func(v int) (int, error) {
+		*calls++
+		if v == bad {
+			return 0, errOdd
+		}
+		return v * 10, nil
+	}
+}
+
+func TestMap(t *testing.T) {
+	got := Map([]int{1, 2, 3}, strconv.Itoa)
+	if want := []string{"1", "2", "3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %v, 期望 %v", got, want)
+	}
+
+	calls := 0
+	if got, err := MapE([]int{1, 2}, failOn(-1, &calls)); err != nil || !reflect.DeepEqual(got, []int{10, 20}) {
+		t.Errorf("MapE() = %v, %v", got, err)
+	}
+
+	calls = 0
+	_, err := MapE([]int{1, 2, 3}, failOn(2, &calls))
+	if !errors.Is(err, errOdd) || err.Error() != "index 1: odd" || calls != 2 {
+		t.Errorf("MapE() error = %v, calls = %d, 应在第一个错误处停止", err, calls)
+	}
+}
+
+func TestFilterAndPartition(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	if got := Filter([]int{1, 2, 3, 4}, even); !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("Filter() = %v", got)
+	}
+	if _, err := FilterE([]int{1, 2}, func(v int) (bool, error) { return false, errOdd }); err == nil || err.Error() != "index 0: odd" {
+		t.Errorf("FilterE() error = %v", err)
+	}
+
+	matched, rest := Partition([]int{1, 2, 3, 4, 5}, even)
+	if !reflect.DeepEqual(matched, []int{2, 4}) || !reflect.DeepEqual(rest, []int{1, 3, 5}) {
+		t.Errorf("Partition() = %v, %v", matched, rest)
+	}
+	if _, _, err := PartitionE([]int{2, 3}, func(v int) (bool, error) {
+		if v == 3 {
+			return false, errOdd
+		}
+		return true, nil
+	}); !errors.Is(err, errOdd) {
+		t.Errorf("PartitionE() error = %v", err)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := Reduce([]int{1, 2, 3}, 0, func(acc, v int) int { return acc + v })
+	if sum != 6 {
+		t.Errorf("Reduce() = %d", sum)
+	}
+	joined := Reduce([]int{1, 2}, "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if joined != "12" {
+		t.Errorf("Reduce() = %q", joined)
+	}
+
+	got, err := ReduceE([]string{"1", "x"}, 0, func(acc int, s string) (int, error) {
+		v, err := many.ToE[int](s)
+		return acc + v, err
+	})
+	if got != 0 || err == nil || !strings.HasPrefix(err.Error(), "index 1: ") {
+		t.Errorf("ReduceE() = %d, %v", got, err)
+	}
+}
+
+func TestGroupByAndKeyBy(t *testing.T) {
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	first := func(s string) byte { return s[0] }
+
+	groups := GroupBy(words, first)
+	want := map[byte][]string{'a': {"apple", "avocado"}, 'b': {"banana", "blueberry"}, 'c': {"cherry"}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("GroupBy() = %v", groups)
+	}
+	if _, err := GroupByE(words, func(s string) (byte, error) { return 0, errOdd }); !errors.Is(err, errOdd) {
+		t.Errorf("GroupByE() error = %v", err)
+	}
+
+	// 键重复时保留最后一个
+	byFirst := KeyBy(words, first)
+	if byFirst['a'] != "avocado" || byFirst['c'] != "cherry" || len(byFirst) != 3 {
+		t.Errorf("KeyBy() = %v", byFirst)
+	}
+	if _, err := KeyByE(words, func(s string) (byte, error) { return 0, errOdd }); !errors.Is(err, errOdd) {
+		t.Errorf("KeyByE() error = %v", err)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		size int
+		want [][]int
+	}{
+		{"整除", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"最后一组不足", []int{1, 2, 3}, 2, [][]int{{1, 2}, {3}}},
+		{"size 大于长度", []int{1}, 5, [][]int{{1}}},
+		{"空切片", nil, 3, [][]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Chunk(tt.in, tt.size); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chunk() = %v, 期望 %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("append 不覆盖下一组", func(t *testing.T) {
+		s := []int{1, 2, 3, 4}
+		chunks := Chunk(s, 2)
+		_ = append(chunks[0], 99)
+		if s[2] != 3 {
+			t.Errorf("s = %v", s)
+		}
+	})
+
+	t.Run("size 非正数 panic", func(t *testing.T) {
+		if _, err := many.Try(func() [][]int { return Chunk([]int{1}, 0) }); err == nil {
+			t.Error("Chunk(s, 0) 应 panic")
+		}
+	})
+}
+
+func TestUniq(t *testing.T) {
+	if got := Uniq([]int{3, 1, 3, 2, 1}); !reflect.DeepEqual(got, []int{3, 1, 2}) {
+		t.Errorf("Uniq() = %v", got)
+	}
+	got := UniqBy([]string{"a", "B", "A", "b", "c"}, strings.ToLower)
+	if !reflect.DeepEqual(got, []string{"a", "B", "c"}) {
+		t.Errorf("UniqBy() = %v", got)
+	}
+	if _, err := UniqByE([]string{"a"}, func(string) (string, error) { return "", errOdd }); !errors.Is(err, errOdd) {
+		t.Errorf("UniqByE() error = %v", err)
+	}
+}
+
+func TestFlattenZip(t *testing.T) {
+	if got := Flatten([][]int{{1}, nil, {2, 3}}); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Flatten() = %v", got)
+	}
+
+	pairs := Zip([]string{"a", "b", "c"}, []int{1, 2})
+	if want := []Pair[string, int]{{"a", 1}, {"b", 2}}; !reflect.DeepEqual(pairs, want) {
+		t.Errorf("Zip() = %v", pairs)
+	}
+	names, nums := Unzip(pairs)
+	if !reflect.DeepEqual(names, []string{"a", "b"}) || !reflect.DeepEqual(nums, []int{1, 2}) {
+		t.Errorf("Unzip() = %v, %v", names, nums)
+	}
+}
+
+func TestConvertAll(t *testing.T) {
+	if got := ConvertAll[int]([]any{"1", 2.9, true, "x"}); !reflect.DeepEqual(got, []int{1, 2, 1, 0}) {
+		t.Errorf("ConvertAll() = %v", got)
+	}
+	if got := ConvertAll[float64]([]string{"1.5", "2"}); !reflect.DeepEqual(got, []float64{1.5, 2}) {
+		t.Errorf("ConvertAll([]string) = %v", got)
+	}
+
+	got, err := ConvertAllE[int]([]any{"1", "x", 3, "y"})
+	if !reflect.DeepEqual(got, []int{1, 0, 3, 0}) {
+		t.Errorf("ConvertAllE() = %v", got)
+	}
+	var batchErr *many.BatchError
+	if !errors.As(err, &batchErr) || !reflect.DeepEqual(batchErr.Indices, []int{1, 3}) {
+		t.Errorf("ConvertAllE() error = %v", err)
+	}
+	if _, err := ConvertAllE[int]([]string{"1", "2"}); err != nil {
+		t.Errorf("ConvertAllE() error = %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package many
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// strictConverter StrictTo/StrictToE 使用的 Converter
+var strictConverter = NewConverter(WithProfile(ProfileStrict))
+
+// StrictTo 与 ConvertTo 相同, 使用 ProfileStrict, 无法精确转换时返回零值
+func StrictTo[T any](v any) T {
+	return ConvertTo[T](strictConverter, v)
+}
+
+// StrictToE 与 ConvertToE 相同, 使用 ProfileStrict, 输入无法精确表示为 T 或转换会丢失信息时返回错误
+func StrictToE[T any](v any) (T, error) {
+	return ConvertToE[T](strictConverter, v)
+}
+
+// lossError 严格模式下转换丢失信息时返回的错误
+func lossError[T any](v any) error {
+	var zero T
+	return fmt.Errorf("converting %T %v to %T loses information", v, v, zero)
+}
+
+// losesFraction 源值带小数部分时, 转换为整数会丢失信息
+func losesFraction(v any) bool {
+	switch val := v.(type) {
+	case float32:
+		return hasFraction(float64(val))
+	case float64:
+		return hasFraction(val)
+	case complex64, complex128:
+		r, _ := realPart(val)
+		return hasFraction(r)
+	case string:
+		return stringHasFraction(val)
+	case json.Number:
+		return stringHasFraction(string(val))
+	default:
+		return false
+	}
+}
+
+// hasFraction 判断有限的浮点数是否带小数部分, ±Inf 与 NaN 由范围检查处理
+func hasFraction(f float64) bool {
+	return !math.IsInf(f, 0) && !math.IsNaN(f) && f != math.Trunc(f)
+}
+
+// stringHasFraction 按十进制精确判断字符串表示的数值是否带小数部分,
+// 避免 "1.0000000000000001" 这类解析为 float64 后恰好是整数的输入
+func stringHasFraction(s string) bool {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return false
+	}
+	if f == 0 {
+		// 下溢为 0 的非零数值, 如 "1e-400"
+		mantissa, _, _ := strings.Cut(strings.ToLower(s), "e")
+		return strings.ContainsAny(mantissa, "123456789")
+	}
+	// f 有限且非零, 指数有界, big.Rat 的开销与输入长度成正比
+	r, ok := new(big.Rat).SetString(s)
+	return ok && !r.IsInt()
+}
+
+// losesFloat64 超过 2^53 且无法精确表示的整数转换为 float64 会丢失信息
+func losesFloat64(v any) bool {
+	return losesFloat(v, 64)
+}
+
+// losesFloat32 无法精确表示为 float32 的整数与 float64 转换为 float32 会丢失信息
+// 十进制小数字符串本身就无法精确表示, 只检查其中的整数
+func losesFloat32(v any) bool {
+	switch val := v.(type) {
+	case float64:
+		return !fitsFloat32(val)
+	case complex128:
+		r, _ := realPart(val)
+		return !fitsFloat32(r)
+	default:
+		return losesFloat(v, 32)
+	}
+}
+
+// losesFloat 判断整数输入转换为 bitSize 位浮点数是否丢失信息
+func losesFloat(v any, bitSize int) bool {
+	switch val := v.(type) {
+	case int:
+		return !intFitsFloat(int64(val), bitSize)
+	case int32:
+		return !intFitsFloat(int64(val), bitSize)
+	case int64:
+		return !intFitsFloat(val, bitSize)
+	case uint:
+		return !uintFitsFloat(uint64(val), bitSize)
+	case uint32:
+		return !uintFitsFloat(uint64(val), bitSize)
+	case uint64:
+		return !uintFitsFloat(val, bitSize)
+	case string:
+		return stringLosesFloat(val, bitSize)
+	case json.Number:
+		return stringLosesFloat(string(val), bitSize)
+	default:
+		// int8, int16, uint8, uint16 总能精确表示
+		return false
+	}
+}
+
+func stringLosesFloat(s string, bitSize int) bool {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return !intFitsFloat(i, bitSize)
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return !uintFitsFloat(u, bitSize)
+	}
+	return false
+}
+
+// intFitsFloat 判断 i 能否精确表示为 bitSize 位浮点数
+// float64(math.MaxInt64) 等于 2^63, 已超出 int64 范围, 需先比较再转换回整数
+func intFitsFloat(i int64, bitSize int) bool {
+	f := float64(i)
+	if f >= 1<<63 || int64(f) != i {
+		return false
+	}
+	return bitSize == 64 || fitsFloat32(f)
+}
+
+func uintFitsFloat(u uint64, bitSize int) bool {
+	f := float64(u)
+	if f >= 1<<64 || uint64(f) != u {
+		return false
+	}
+	return bitSize == 64 || fitsFloat32(f)
+}
+
+// fitsFloat32 判断 f 能否精确表示为 float32, NaN 与 ±Inf 视为可以
+func fitsFloat32(f float64) bool {
+	return float64(float32(f)) == f || math.IsNaN(f) || math.IsInf(f, 0)
+}
+
+// losesComplex64 实部或虚部无法精确表示为 float32 时, 转换为 complex64 会丢失信息
+func losesComplex64(v any) bool {
+	if c, ok := v.(complex128); ok {
+		return !fitsFloat32(real(c)) || !fitsFloat32(imag(c))
+	}
+	return losesFloat32(v)
+}
+
+// losesString 结构体等类型经 JSON 序列化的结果不是可靠的文本形式, 浮点数的文本形式总能解析回原值
+func losesString(v any) bool {
+	switch v.(type) {
+	case nil, string, json.Number, []byte, []rune, fmt.Stringer, encoding.TextMarshaler, bool,
+		int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64,
+		float32, float64, complex64, complex128:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,86 @@
+package many
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestStrictToE(t *testing.T) {
+	type point struct{ X, Y int }
+
+	tests := []struct {
+		name    string
+		conv    func() (any, error)
+		want    any
+		wantErr bool
+	}{
+		{"带小数的浮点数转整数", func() (any, error) { return StrictToE[int](3.5) }, 0, true},
+		{"整数值的浮点数转整数", func() (any, error) { return StrictToE[int](3.0) }, 3, false},
+		{"十进制精确判断小数", func() (any, error) { return StrictToE[int64]("1.0000000000000001") }, int64(0), true},
+		{"下溢的小数", func() (any, error) { return StrictToE[int64]("1e-400") }, int64(0), true},
+		{"json.Number 带小数", func() (any, error) { return StrictToE[int](json.Number("4.2")) }, 0, true},
+		{"2^53 转 float64", func() (any, error) { return StrictToE[float64](int64(1 << 53)) }, float64(1 << 53), false},
+		{"2^53+1 转 float64", func() (any, error) { return StrictToE[float64](int64(1<<53 + 1)) }, 0.0, true},
+		{"MaxInt64 转 float64", func() (any, error) { return StrictToE[float64](int64(math.MaxInt64)) }, 0.0, true},
+		{"MaxUint64 转 float64", func() (any, error) { return StrictToE[float64](uint64(math.MaxUint64)) }, 0.0, true},
+		{"大整数字符串转 float64", func() (any, error) { return StrictToE[float64]("9007199254740993") }, 0.0, true},
+		{"小数字符串转 float64", func() (any, error) { return StrictToE[float64]("0.1") }, 0.1, false},
+		{"2^24+1 转 float32", func() (any, error) { return StrictToE[float32](1<<24 + 1) }, float32(0), true},
+		{"0.1 转 float32", func() (any, error) { return StrictToE[float32](0.1) }, float32(0), true},
+		{"0.5 转 float32", func() (any, error) { return StrictToE[float32](0.5) }, float32(0.5), false},
+		{"complex128 转 complex64", func() (any, error) { return StrictToE[complex64](complex(0.1, 0)) }, complex64(0), true},
+		{"负数转无符号", func() (any, error) { return StrictToE[uint64](-1) }, uint64(0), true},
+		{"无法识别的 bool", func() (any, error) { return StrictToE[bool]("abc") }, false, true},
+		{"结构体转字符串", func() (any, error) { return StrictToE[string](point{1, 2}) }, "", true},
+		{"结构体转 []byte", func() (any, error) { return StrictToE[[]byte](point{1, 2}) }, "", true},
+		{"多位小数转字符串", func() (any, error) { return StrictToE[string](0.125) }, "0.125", false},
+		{"浮点数转字符串", func() (any, error) { return StrictToE[string](0.25) }, "0.25", false},
+		{"Stringer 转字符串", func() (any, error) { return StrictToE[string](NilUUID) }, NilUUID.String(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.conv()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if b, ok := got.([]byte); ok {
+				got = string(b)
+			}
+			if got != tt.want {
+				t.Errorf("got %#v, 期望 %#v", got, tt.want)
+			}
+		})
+	}
+
+	if got := StrictTo[int](2.5); got != 0 {
+		t.Errorf("StrictTo[int](2.5) = %d, 期望 0", got)
+	}
+}
+
+func TestWithStrict(t *testing.T) {
+	// WithStrict 可与其他配置档组合
+	legacy := NewConverter(WithStrict())
+	if !legacy.Strict() || legacy.Profile() != ProfileLegacy {
+		t.Fatalf("Strict() = %v, Profile() = %v", legacy.Strict(), legacy.Profile())
+	}
+	if _, err := ConvertToE[int](legacy, 2.5); err == nil {
+		t.Error("legacy+strict 应拒绝 2.5 转 int")
+	}
+	if got := ConvertTo[int](legacy, "7"); got != 7 {
+		t.Errorf(`ConvertTo[int](legacy, "7") = %d`, got)
+	}
+
+	lenient := NewConverter(WithProfile(ProfileLenient), WithStrict())
+	if got, err := ConvertToE[int](lenient, " 0x10 "); err != nil || got != 16 {
+		t.Errorf(`ConvertToE[int](lenient, " 0x10 ") = %d, %v`, got, err)
+	}
+	if _, err := ConvertToE[int](lenient, " 1.5 "); err == nil {
+		t.Error("lenient+strict 应拒绝 1.5 转 int")
+	}
+
+	if NewConverter().Strict() || (*Converter)(nil).Strict() {
+		t.Error("默认 Converter 不应启用严格模式")
+	}
+}
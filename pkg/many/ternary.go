@@ -9,10 +9,73 @@ func Ternary[T any](condition bool, a, b T) T {
 	return b
 }
 
-// NilToValue 如果 a 为 nil, 返回 fc() 的返回值, 否则返回 a
-func NilToValue[T any](a *T, fc func() any) *T {
-	if a == nil {
-		return fc().(*T)
+// TernaryFunc 与 Ternary 相同, 但只调用被选中的分支, 如 TernaryFunc(p != nil, func() string { return p.Name }, ...)
+func TernaryFunc[T any](condition bool, a, b func() T) T {
+	if condition {
+		return a()
 	}
-	return a
+	return b()
+}
+
+// TernaryE 与 TernaryFunc 相同, 分支可以返回错误
+func TernaryE[T any](condition bool, a, b func() (T, error)) (T, error) {
+	if condition {
+		return a()
+	}
+	return b()
+}
+
+// Cond 多路条件选择, 由 When 或 WhenFunc 创建, 第一个为 true 的条件决定结果, 之后的分支函数不再调用
+//
+//	level := many.When(n > 100, "high").When(n > 10, "medium").Else("low")
+type Cond[T any] struct {
+	matched bool
+	value   T
+}
+
+// When 开始一个条件选择, condition 为 true 时结果为 value
+func When[T any](condition bool, value T) Cond[T] {
+	return Cond[T]{}.When(condition, value)
+}
+
+// WhenFunc 与 When 相同, 但 condition 为 true 时才调用 fn
+func WhenFunc[T any](condition bool, fn func() T) Cond[T] {
+	return Cond[T]{}.WhenFunc(condition, fn)
+}
+
+// When 追加一个分支, 已有分支匹配时忽略
+func (c Cond[T]) When(condition bool, value T) Cond[T] {
+	if !c.matched && condition {
+		return Cond[T]{matched: true, value: value}
+	}
+	return c
+}
+
+// WhenFunc 追加一个分支, 仅在之前的分支都未匹配且 condition 为 true 时调用 fn
+func (c Cond[T]) WhenFunc(condition bool, fn func() T) Cond[T] {
+	if !c.matched && condition {
+		return Cond[T]{matched: true, value: fn()}
+	}
+	return c
+}
+
+// Else 返回匹配分支的结果, 没有分支匹配时返回 value
+func (c Cond[T]) Else(value T) T {
+	if c.matched {
+		return c.value
+	}
+	return value
+}
+
+// ElseFunc 与 Else 相同, 但仅在没有分支匹配时调用 fn
+func (c Cond[T]) ElseFunc(fn func() T) T {
+	if c.matched {
+		return c.value
+	}
+	return fn()
+}
+
+// Value 返回匹配分支的结果, ok 表示是否有分支匹配
+func (c Cond[T]) Value() (value T, ok bool) {
+	return c.value, c.matched
 }
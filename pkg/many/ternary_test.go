@@ -0,0 +1,77 @@
+package many
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTernaryFunc(t *testing.T) {
+	type user struct{ Name string }
+	var p *user
+
+	// 未选中的分支不会被调用, nil 指针不会 panic
+	name := TernaryFunc(p != nil, func() string { return p.Name }, func() string { return "anonymous" })
+	if name != "anonymous" {
+		t.Errorf("TernaryFunc() = %q", name)
+	}
+
+	p = &user{Name: "alice"}
+	if name := TernaryFunc(p != nil, func() string { return p.Name }, func() string { return "" }); name != "alice" {
+		t.Errorf("TernaryFunc() = %q", name)
+	}
+}
+
+func TestTernaryE(t *testing.T) {
+	errBoom := errors.New("boom")
+	ok := func() (int, error) { return 1, nil }
+	fail := func() (int, error) { return 0, errBoom }
+
+	if v, err := TernaryE(true, ok, fail); v != 1 || err != nil {
+		t.Errorf("TernaryE(true) = %d, %v", v, err)
+	}
+	if _, err := TernaryE(false, ok, fail); !errors.Is(err, errBoom) {
+		t.Errorf("TernaryE(false) err = %v", err)
+	}
+}
+
+func TestCond(t *testing.T) {
+	level := func(n int) string {
+		return When(n > 100, "high").When(n > 10, "medium").Else("low")
+	}
+	tests := []struct {
+		name string
+		n    int
+		want string
+	}{
+		{"第一个分支", 200, "high"},
+		{"第二个分支", 50, "medium"},
+		{"默认值", 1, "low"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := level(tt.n); got != tt.want {
+				t.Errorf("level(%d) = %q, 期望 %q", tt.n, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("惰性求值", func(t *testing.T) {
+		calls := 0
+		call := func(v int) func() int {
+			return func() int { calls++; return v }
+		}
+		got := WhenFunc(false, call(1)).WhenFunc(true, call(2)).WhenFunc(true, call(3)).ElseFunc(call(4))
+		if got != 2 || calls != 1 {
+			t.Errorf("got %d, calls = %d, 期望 2, 1", got, calls)
+		}
+	})
+
+	t.Run("Value", func(t *testing.T) {
+		if _, ok := When(false, 1).Value(); ok {
+			t.Error("没有分支匹配时 ok 应为 false")
+		}
+		if v, ok := When(false, 1).When(true, 0).Value(); !ok || v != 0 {
+			t.Errorf("Value() = %d, %v", v, ok)
+		}
+	})
+}
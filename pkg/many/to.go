@@ -1,69 +1,66 @@
 package many
 
 import (
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"math"
-	"reflect"
 	"strconv"
 )
 
 // To 是一个通用的类型转换函数，使用泛型将任意值转换为目标类型 T
 func To[T any](v any) T {
-	var zero T
-
-	// 获取目标类型
-	typeOf := fmt.Sprintf("%T", zero)
-
-	// 查找匹配的转换器
-	switch typeOf {
-	case "bool":
-		return any(toBool(v)).(T)
-	case "string":
-		return any(toString(v)).(T)
-	case "int":
-		return any(toInt(v)).(T)
-	case "int8":
-		return any(int8(toInt64(v))).(T)
-	case "int16":
-		return any(int16(toInt64(v))).(T)
-	case "int32":
-		return any(int32(toInt64(v))).(T)
-	case "int64":
-		return any(toInt64(v)).(T)
-	case "uint":
-		return any(toUint(v)).(T)
-	case "uint8":
-		return any(uint8(toUint64(v))).(T)
-	case "uint16":
-		return any(uint16(toUint64(v))).(T)
-	case "uint32":
-		return any(uint32(toUint64(v))).(T)
-	case "uint64":
-		return any(toUint64(v)).(T)
-	case "float32":
-		return any(float32(toFloat64(v))).(T)
-	case "float64":
-		return any(toFloat64(v)).(T)
-	default:
-		return zero
-	}
+	return dispatcherOf[T]().convert(v)
 }
 
 // ToE 带错误返回的类型转换函数
 func ToE[T any](v any) (T, error) {
-	var zero T
-	result := To[T](v)
+	return dispatcherOf[T]().convertE(v)
+}
+
+// 以下是内部辅助函数，不对外暴露
 
-	// 使用反射判断是否为零值
-	if v != nil && reflect.ValueOf(result).IsZero() {
-		return zero, fmt.Errorf("cannot convert %T to %T", v, zero)
+// unmarshalTo 当 *T 实现了 encoding.TextUnmarshaler 或 json.Unmarshaler 且 v 为字符串或 []byte 时,
+// 使用对应的方法完成转换, ok 表示是否走了该路径
+func unmarshalTo[T any](v any) (result T, ok bool, err error) {
+	target := any(&result)
+	textU, isText := target.(encoding.TextUnmarshaler)
+	jsonU, isJSON := target.(json.Unmarshaler)
+	if !isText && !isJSON {
+		return result, false, nil
 	}
 
-	return result, nil
-}
+	var data []byte
+	switch val := v.(type) {
+	case string:
+		data = []byte(val)
+	case []byte:
+		data = val
+	case json.Number:
+		data = []byte(val)
+	default:
+		return result, false, nil
+	}
 
-// 以下是内部辅助函数，不对外暴露
+	if isText {
+		err = textU.UnmarshalText(data)
+	} else {
+		err = jsonU.UnmarshalJSON(data)
+		if err != nil && !json.Valid(data) {
+			// 非 JSON 文本按 JSON 字符串再试一次
+			var retry T
+			quoted, _ := json.Marshal(string(data))
+			if any(&retry).(json.Unmarshaler).UnmarshalJSON(quoted) == nil {
+				return retry, true, nil
+			}
+		}
+	}
+	if err != nil {
+		var zero T
+		return zero, true, fmt.Errorf("cannot convert %q to %T: %w", data, zero, err)
+	}
+	return result, true, nil
+}
 
 // toFloat64 将各种类型转换为float64
 func toFloat64(v any) float64 {
@@ -73,10 +70,7 @@ func toFloat64(v any) float64 {
 
 	switch val := v.(type) {
 	case string:
-		if f, err := strconv.ParseFloat(val, 64); err == nil {
-			return f
-		}
-		return 0
+		return parseFloat64(val)
 	case bool:
 		if val {
 			return 1
@@ -106,6 +100,12 @@ func toFloat64(v any) float64 {
 		return float64(val)
 	case float64:
 		return val
+	case complex64, complex128:
+		// 仅当虚部为 0 时取实部
+		if r, ok := realPart(val); ok {
+			return r
+		}
+		return 0
 	case json.Number:
 		if f, err := val.Float64(); err == nil {
 			return f
@@ -147,18 +147,16 @@ func toInt64(v any) int64 {
 		}
 		return int64(val)
 	case string:
-		if i, err := strconv.ParseInt(val, 10, 64); err == nil {
-			return i
-		}
-		// 尝试浮点数解析然后转整数
-		if f, err := strconv.ParseFloat(val, 64); err == nil {
-			return int64(f)
-		}
-		return 0
+		return parseInt64(val)
 	case float32:
-		return int64(val)
+		return floatToInt64(float64(val))
 	case float64:
-		return int64(val)
+		return floatToInt64(val)
+	case complex64, complex128:
+		if r, ok := realPart(val); ok {
+			return floatToInt64(r)
+		}
+		return 0
 	case bool:
 		if val {
 			return 1
@@ -169,7 +167,7 @@ func toInt64(v any) int64 {
 			return i
 		}
 		if f, err := val.Float64(); err == nil {
-			return int64(f)
+			return floatToInt64(f)
 		}
 		return 0
 	default:
@@ -220,24 +218,16 @@ func toUint64(v any) uint64 {
 		}
 		return uint64(val)
 	case string:
-		if u, err := strconv.ParseUint(val, 10, 64); err == nil {
-			return u
-		}
-		// 尝试浮点数解析然后转无符号整数
-		if f, err := strconv.ParseFloat(val, 64); err == nil && f >= 0 {
-			return uint64(f)
-		}
-		return 0
+		return parseUint64(val)
 	case float32:
-		if val < 0 {
-			return 0
-		}
-		return uint64(val)
+		return floatToUint64(float64(val))
 	case float64:
-		if val < 0 {
-			return 0
+		return floatToUint64(val)
+	case complex64, complex128:
+		if r, ok := realPart(val); ok {
+			return floatToUint64(r)
 		}
-		return uint64(val)
+		return 0
 	case bool:
 		if val {
 			return 1
@@ -247,8 +237,8 @@ func toUint64(v any) uint64 {
 		if u, err := strconv.ParseUint(string(val), 10, 64); err == nil {
 			return u
 		}
-		if f, err := val.Float64(); err == nil && f >= 0 {
-			return uint64(f)
+		if f, err := val.Float64(); err == nil {
+			return floatToUint64(f)
 		}
 		return 0
 	default:
@@ -266,12 +256,7 @@ func toBool(v any) bool {
 	case bool:
 		return val
 	case string:
-		switch val {
-		case "1", "t", "T", "true", "TRUE", "True", "yes", "YES", "Yes", "y", "Y", "on", "ON", "On":
-			return true
-		default:
-			return false
-		}
+		return parseBool(val)
 	case int:
 		return val == 1
 	case int8:
@@ -301,6 +286,129 @@ func toBool(v any) bool {
 	}
 }
 
+// toComplex128 将各种类型转换为complex128
+func toComplex128(v any) complex128 {
+	if v == nil {
+		return 0
+	}
+
+	switch val := v.(type) {
+	case complex128:
+		return val
+	case complex64:
+		return complex128(val)
+	case string:
+		return parseComplex128(val)
+	case json.Number:
+		return parseComplex128(string(val))
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return complex(toFloat64(val), 0)
+	default:
+		return 0
+	}
+}
+
+// 以下 parse 函数是各转换函数对字符串输入的处理, 供不经过 any 装箱的批量转换复用
+
+// parseFloat64 将字符串解析为float64, 失败时返回 0
+func parseFloat64(s string) float64 {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return 0
+}
+
+// parseInt64 将字符串解析为int64, 失败时返回 0
+func parseInt64(s string) int64 {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	// 尝试浮点数解析然后转整数
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return floatToInt64(f)
+	}
+	return 0
+}
+
+// parseUint64 将字符串解析为uint64, 失败时返回 0
+func parseUint64(s string) uint64 {
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return u
+	}
+	// 尝试浮点数解析然后转无符号整数
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return floatToUint64(f)
+	}
+	return 0
+}
+
+// parseBool 将字符串解析为bool, 仅识别常见的真值写法
+func parseBool(s string) bool {
+	switch s {
+	case "1", "t", "T", "true", "TRUE", "True", "yes", "YES", "Yes", "y", "Y", "on", "ON", "On":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseComplex128 将字符串解析为complex128, 失败时返回 0
+func parseComplex128(s string) complex128 {
+	if c, err := strconv.ParseComplex(s, 128); err == nil {
+		return c
+	}
+	return 0
+}
+
+// floatToInt64 截断小数部分, 超出 int64 范围时取边界值, NaN 返回 0
+// 直接转换在超出范围时的结果与平台相关
+func floatToInt64(f float64) int64 {
+	switch {
+	case f != f:
+		return 0
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	default:
+		return int64(f)
+	}
+}
+
+// floatToUint64 截断小数部分, 负数返回 0, 超出 uint64 范围时取最大值, NaN 返回 0
+func floatToUint64(f float64) uint64 {
+	switch {
+	case f != f || f < 0:
+		return 0
+	case f >= math.MaxUint64:
+		return math.MaxUint64
+	default:
+		return uint64(f)
+	}
+}
+
+// realPart 返回复数的实部, 虚部不为 0 时 ok 为 false
+func realPart(v any) (float64, bool) {
+	var c complex128
+	switch val := v.(type) {
+	case complex64:
+		c = complex128(val)
+	case complex128:
+		c = val
+	default:
+		return 0, false
+	}
+	if imag(c) != 0 {
+		return 0, false
+	}
+	return real(c), true
+}
+
+// formatComplex 格式化复数, 与 strconv.FormatComplex 相同但不带外层括号, 如 "1+2i"
+func formatComplex(c complex128, bitSize int) string {
+	return string(appendComplex(nil, c, bitSize))
+}
+
 // toInt 将各种类型转换为int
 func toInt(v any) int {
 	return int(toInt64(v))
@@ -322,6 +430,10 @@ func toString(v any) string {
 		return val
 	case json.Number:
 		return string(val)
+	case []byte:
+		return string(val)
+	case []rune:
+		return string(val)
 	case fmt.Stringer:
 		return val.String()
 	case bool:
@@ -330,37 +442,40 @@ func toString(v any) string {
 		}
 		return "false"
 	case int:
-		return strconv.FormatInt(int64(val), 10)
+		return formatInt(int64(val))
 	case int8:
-		return strconv.FormatInt(int64(val), 10)
+		return formatInt(int64(val))
 	case int16:
-		return strconv.FormatInt(int64(val), 10)
+		return formatInt(int64(val))
 	case int32:
-		return strconv.FormatInt(int64(val), 10)
+		return formatInt(int64(val))
 	case int64:
-		return strconv.FormatInt(val, 10)
+		return formatInt(val)
 	case uint:
-		return strconv.FormatUint(uint64(val), 10)
+		return formatUint(uint64(val))
 	case uint8:
-		return strconv.FormatUint(uint64(val), 10)
+		return formatUint(uint64(val))
 	case uint16:
-		return strconv.FormatUint(uint64(val), 10)
+		return formatUint(uint64(val))
 	case uint32:
-		return strconv.FormatUint(uint64(val), 10)
+		return formatUint(uint64(val))
 	case uint64:
-		return strconv.FormatUint(val, 10)
+		return formatUint(val)
 	case float32:
-		// 整数形式的浮点数不显示小数点
-		if float32(math.Floor(float64(val))) == val {
-			return strconv.FormatInt(int64(val), 10)
-		}
-		return strconv.FormatFloat(float64(val), 'f', 2, 32)
+		return formatFloat(float64(val), 32)
 	case float64:
-		// 整数形式的浮点数不显示小数点
-		if math.Floor(val) == val {
-			return strconv.FormatInt(int64(val), 10)
+		return formatFloat(val, 64)
+	case complex64:
+		return formatComplex(complex128(val), 64)
+	case complex128:
+		return formatComplex(val, 128)
+	case encoding.TextMarshaler:
+		// 优先使用 MarshalText, 避免 JSON 序列化带来的引号
+		data, err := val.MarshalText()
+		if err != nil {
+			return ""
 		}
-		return strconv.FormatFloat(val, 'f', 2, 64)
+		return string(data)
 	default:
 		// 尝试JSON序列化
 		data, err := json.Marshal(val)
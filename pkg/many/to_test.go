@@ -2,7 +2,11 @@ package many
 
 import (
 	"encoding/json"
+	"errors"
+	"net"
+	"net/netip"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -259,3 +263,136 @@ func TestDefaultValues(t *testing.T) {
 		t.Errorf("期望返回零值 %+v, 得到 %+v", customType{}, result)
 	}
 }
+
+func TestToComplex(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected complex128
+	}{
+		{"字符串复数", "1+2i", complex(1, 2)},
+		{"字符串带括号", "(3-4i)", complex(3, -4)},
+		{"字符串实数", "1.5", complex(1.5, 0)},
+		{"字符串无效", "abc", 0},
+		{"整数", 7, complex(7, 0)},
+		{"浮点数", 2.5, complex(2.5, 0)},
+		{"complex64", complex64(complex(1, -1)), complex(1, -1)},
+		{"json.Number", json.Number("2+3i"), complex(2, 3)},
+		{"nil", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := To[complex128](tt.input); got != tt.expected {
+				t.Errorf("To[complex128](%v) = %v, 期望 %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	if got := To[complex64]("1+2i"); got != complex64(complex(1, 2)) {
+		t.Errorf("To[complex64](\"1+2i\") = %v, 期望 %v", got, complex(1, 2))
+	}
+}
+
+func TestComplexToReal(t *testing.T) {
+	// 虚部为 0 时可转换为实数
+	if got, err := ToE[float64](complex(3.5, 0)); err != nil || got != 3.5 {
+		t.Errorf("ToE[float64](3.5+0i) = %v, %v, 期望 3.5, nil", got, err)
+	}
+	if got, err := ToE[int](complex64(complex(8, 0))); err != nil || got != 8 {
+		t.Errorf("ToE[int](8+0i) = %v, %v, 期望 8, nil", got, err)
+	}
+
+	// 虚部不为 0 时返回错误
+	if _, err := ToE[float64](complex(1, 2)); err == nil {
+		t.Errorf("ToE[float64](1+2i) 期望返回错误")
+	}
+	if _, err := ToE[uint](complex(1, 2)); err == nil {
+		t.Errorf("ToE[uint](1+2i) 期望返回错误")
+	}
+	if got := To[int](complex(1, 2)); got != 0 {
+		t.Errorf("To[int](1+2i) = %d, 期望 0", got)
+	}
+
+	// 复数格式化为字符串
+	if got := To[string](complex(1, 2)); got != "1+2i" {
+		t.Errorf("To[string](1+2i) = %q, 期望 %q", got, "1+2i")
+	}
+	if got := To[string](complex64(complex(0.5, -1))); got != "0.5-1i" {
+		t.Errorf("To[string](0.5-1i) = %q, 期望 %q", got, "0.5-1i")
+	}
+}
+
+// testID 仅实现了 TextMarshaler/TextUnmarshaler 的自定义类型
+type testID struct {
+	n string
+}
+
+func (id testID) MarshalText() ([]byte, error) {
+	return []byte("id-" + id.n), nil
+}
+
+func (id *testID) UnmarshalText(b []byte) error {
+	s, ok := strings.CutPrefix(string(b), "id-")
+	if !ok {
+		return errors.New("missing id- prefix")
+	}
+	id.n = s
+	return nil
+}
+
+// testJSONValue 仅实现了 json.Unmarshaler 的自定义类型
+type testJSONValue struct {
+	s string
+}
+
+func (j *testJSONValue) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, &j.s)
+}
+
+func TestTextUnmarshaler(t *testing.T) {
+	if got := To[netip.Addr]("192.168.1.1"); got != netip.MustParseAddr("192.168.1.1") {
+		t.Errorf("To[netip.Addr] = %v, 期望 192.168.1.1", got)
+	}
+	if got := To[net.IP]([]byte("10.0.0.1")); !got.Equal(net.IPv4(10, 0, 0, 1)) {
+		t.Errorf("To[net.IP] = %v, 期望 10.0.0.1", got)
+	}
+	if got := To[testID]("id-42"); got.n != "42" {
+		t.Errorf("To[testID] = %+v, 期望 n=42", got)
+	}
+
+	// ToE 返回 UnmarshalText 的错误
+	_, err := ToE[testID]("42")
+	if err == nil || !strings.Contains(err.Error(), "missing id- prefix") {
+		t.Errorf("ToE[testID](\"42\") 错误 = %v, 期望包含 UnmarshalText 的错误", err)
+	}
+	if _, err := ToE[netip.Addr]("not-an-ip"); err == nil {
+		t.Errorf("ToE[netip.Addr](\"not-an-ip\") 期望返回错误")
+	}
+
+	// 源值已经是目标类型时原样返回
+	id := testID{n: "7"}
+	if got := To[testID](id); got != id {
+		t.Errorf("To[testID](%+v) = %+v", id, got)
+	}
+}
+
+func TestJSONUnmarshaler(t *testing.T) {
+	if got := To[testJSONValue](`"quoted"`); got.s != "quoted" {
+		t.Errorf("To[testJSONValue] JSON 文本 = %q, 期望 %q", got.s, "quoted")
+	}
+	// 非 JSON 文本按 JSON 字符串处理
+	if got := To[testJSONValue]("plain"); got.s != "plain" {
+		t.Errorf("To[testJSONValue] 普通文本 = %q, 期望 %q", got.s, "plain")
+	}
+	if _, err := ToE[testJSONValue]("123"); err == nil {
+		t.Errorf("ToE[testJSONValue](\"123\") 期望返回错误")
+	}
+}
+
+func TestTextMarshalerToString(t *testing.T) {
+	// MarshalText 优先于 JSON 序列化
+	if got := To[string](testID{n: "9"}); got != "id-9" {
+		t.Errorf("To[string](testID) = %q, 期望 %q", got, "id-9")
+	}
+}
@@ -0,0 +1,241 @@
+package many
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ULID 是 48 位毫秒时间戳加 80 位随机数组成的可排序标识符,
+// 文本形式为 26 位 Crockford Base32
+type ULID [16]byte
+
+// crockford Crockford Base32 字母表
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// crockfordDec Crockford Base32 解码表, 0xff 表示非法字符
+var crockfordDec = func() [256]byte {
+	var t [256]byte
+	for i := range t {
+		t[i] = 0xff
+	}
+	for i := 0; i < len(crockford); i++ {
+		t[crockford[i]] = byte(i)
+		t[crockford[i]|0x20] = byte(i) // 小写
+	}
+	return t
+}()
+
+// ulidMaxTime ULID 可表示的最大毫秒时间戳
+const ulidMaxTime = 1<<48 - 1
+
+// ulidGen 默认的单调生成器
+var ulidGen = &monotonicULID{}
+
+// monotonicULID 同一毫秒内生成的 ULID 在随机部分上递增, 保证严格有序
+type monotonicULID struct {
+	mu      sync.Mutex
+	lastMs  uint64
+	entropy [10]byte
+}
+
+// NewULID 以当前时间生成 ULID, 同一毫秒内的多次调用保持单调递增
+// 时间戳超过 48 位 (公元 10889 年之后) 时 panic, 与 ULID 规范要求的拒绝生成一致
+func NewULID() ULID {
+	return ulidGen.next(uint64(time.Now().UnixMilli()))
+}
+
+func (g *monotonicULID) next(ms uint64) ULID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if ms <= g.lastMs {
+		// 时钟未前进或回拨时沿用上一个时间戳并递增随机部分
+		ms = g.lastMs
+		if !incEntropy(&g.entropy) {
+			// 随机部分溢出, 借用下一毫秒
+			ms++
+			_, _ = rand.Read(g.entropy[:])
+		}
+	} else {
+		_, _ = rand.Read(g.entropy[:])
+	}
+	if ms > ulidMaxTime {
+		panic(fmt.Sprintf("ULID timestamp %d exceeds %d", ms, uint64(ulidMaxTime)))
+	}
+	g.lastMs = ms
+
+	var u ULID
+	u.setTime(ms)
+	copy(u[6:], g.entropy[:])
+	return u
+}
+
+// incEntropy 将随机部分按大端加 1, 溢出时返回 false
+func incEntropy(e *[10]byte) bool {
+	for i := len(e) - 1; i >= 0; i-- {
+		e[i]++
+		if e[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseULID 解析 26 位 Crockford Base32 字符串, 不区分大小写
+func ParseULID(s string) (ULID, error) {
+	var u ULID
+	if len(s) != 26 {
+		return u, fmt.Errorf("invalid ULID length %d in %q", len(s), s)
+	}
+	var d [26]byte
+	for i := 0; i < 26; i++ {
+		d[i] = crockfordDec[s[i]]
+		if d[i] == 0xff {
+			return ULID{}, fmt.Errorf("invalid ULID character %q in %q", s[i], s)
+		}
+	}
+	// 26 个字符共 130 位, 最高 2 位必须为 0
+	if d[0] > 7 {
+		return ULID{}, fmt.Errorf("ULID %q overflows 128 bits", s)
+	}
+
+	u[0] = d[0]<<5 | d[1]
+	u[1] = d[2]<<3 | d[3]>>2
+	u[2] = d[3]<<6 | d[4]<<1 | d[5]>>4
+	u[3] = d[5]<<4 | d[6]>>1
+	u[4] = d[6]<<7 | d[7]<<2 | d[8]>>3
+	u[5] = d[8]<<5 | d[9]
+	u[6] = d[10]<<3 | d[11]>>2
+	u[7] = d[11]<<6 | d[12]<<1 | d[13]>>4
+	u[8] = d[13]<<4 | d[14]>>1
+	u[9] = d[14]<<7 | d[15]<<2 | d[16]>>3
+	u[10] = d[16]<<5 | d[17]
+	u[11] = d[18]<<3 | d[19]>>2
+	u[12] = d[19]<<6 | d[20]<<1 | d[21]>>4
+	u[13] = d[21]<<4 | d[22]>>1
+	u[14] = d[22]<<7 | d[23]<<2 | d[24]>>3
+	u[15] = d[24]<<5 | d[25]
+	return u, nil
+}
+
+// MustParseULID 与 ParseULID 相同, 解析失败时 panic
+func MustParseULID(s string) ULID {
+	u, err := ParseULID(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// IsValidULID 判断 s 是否为合法的 ULID 字符串
+func IsValidULID(s string) bool {
+	_, err := ParseULID(s)
+	return err == nil
+}
+
+// String 返回 26 位大写 Crockford Base32
+func (u ULID) String() string {
+	var buf [26]byte
+	u.encode(buf[:])
+	return string(buf[:])
+}
+
+// Time 返回 ULID 中的时间戳
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(int64(u.Timestamp()))
+}
+
+// Timestamp 返回 ULID 中的 Unix 毫秒时间戳
+func (u ULID) Timestamp() uint64 {
+	return uint64(u[0])<<40 | uint64(u[1])<<32 | uint64(u[2])<<24 |
+		uint64(u[3])<<16 | uint64(u[4])<<8 | uint64(u[5])
+}
+
+// IsZero 判断是否为全 0 的 ULID
+func (u ULID) IsZero() bool {
+	return u == ULID{}
+}
+
+// Compare 按字节序比较两个 ULID, 与按时间排序一致
+func (u ULID) Compare(other ULID) int {
+	return bytes.Compare(u[:], other[:])
+}
+
+// MarshalText 实现 encoding.TextMarshaler
+func (u ULID) MarshalText() ([]byte, error) {
+	buf := make([]byte, 26)
+	u.encode(buf)
+	return buf, nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler
+func (u *ULID) UnmarshalText(b []byte) error {
+	parsed, err := ParseULID(string(b))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+func (u *ULID) setTime(ms uint64) {
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+}
+
+func (u ULID) encode(dst []byte) {
+	dst[0] = crockford[u[0]>>5]
+	dst[1] = crockford[u[0]&31]
+	dst[2] = crockford[u[1]>>3]
+	dst[3] = crockford[(u[1]&7)<<2|u[2]>>6]
+	dst[4] = crockford[(u[2]>>1)&31]
+	dst[5] = crockford[(u[2]&1)<<4|u[3]>>4]
+	dst[6] = crockford[(u[3]&15)<<1|u[4]>>7]
+	dst[7] = crockford[(u[4]>>2)&31]
+	dst[8] = crockford[(u[4]&3)<<3|u[5]>>5]
+	dst[9] = crockford[u[5]&31]
+	dst[10] = crockford[u[6]>>3]
+	dst[11] = crockford[(u[6]&7)<<2|u[7]>>6]
+	dst[12] = crockford[(u[7]>>1)&31]
+	dst[13] = crockford[(u[7]&1)<<4|u[8]>>4]
+	dst[14] = crockford[(u[8]&15)<<1|u[9]>>7]
+	dst[15] = crockford[(u[9]>>2)&31]
+	dst[16] = crockford[(u[9]&3)<<3|u[10]>>5]
+	dst[17] = crockford[u[10]&31]
+	dst[18] = crockford[u[11]>>3]
+	dst[19] = crockford[(u[11]&7)<<2|u[12]>>6]
+	dst[20] = crockford[(u[12]>>1)&31]
+	dst[21] = crockford[(u[12]&1)<<4|u[13]>>4]
+	dst[22] = crockford[(u[13]&15)<<1|u[14]>>7]
+	dst[23] = crockford[(u[14]>>2)&31]
+	dst[24] = crockford[(u[14]&3)<<3|u[15]>>5]
+	dst[25] = crockford[u[15]&31]
+}
+
+// toULIDE 将字符串、[16]byte 或 16 字节的 []byte 转换为 ULID
+func toULIDE(v any) (ULID, error) {
+	switch val := v.(type) {
+	case nil:
+		return ULID{}, nil
+	case ULID:
+		return val, nil
+	case [16]byte:
+		return ULID(val), nil
+	case []byte:
+		if len(val) == 16 {
+			return ULID(val), nil
+		}
+		return ParseULID(string(val))
+	}
+	if s, ok := textOf(v); ok {
+		return ParseULID(s)
+	}
+	return ULID{}, fmt.Errorf("cannot convert %T to ULID", v)
+}
@@ -0,0 +1,104 @@
+package many
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseULID(t *testing.T) {
+	const s = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+	u, err := ParseULID(s)
+	if err != nil {
+		t.Fatalf("ParseULID(%q) 错误 = %v", s, err)
+	}
+	if u.Timestamp() != 1469922850259 {
+		t.Errorf("Timestamp() = %d, 期望 1469922850259", u.Timestamp())
+	}
+	if u.String() != s {
+		t.Errorf("String() = %q, 期望 %q", u.String(), s)
+	}
+	if lower, _ := ParseULID("01arz3ndektsv4rrffq69g5fav"); lower != u {
+		t.Errorf("小写解析结果不一致: %v", lower)
+	}
+
+	for _, bad := range []string{"", "01ARZ3NDEKTSV4RRFFQ69G5FA", "01ARZ3NDEKTSV4RRFFQ69G5FAU!", "01ARZ3NDEKTSV4RRFFQ69G5FAI", "81ARZ3NDEKTSV4RRFFQ69G5FAV"} {
+		if IsValidULID(bad) {
+			t.Errorf("IsValidULID(%q) = true, 期望 false", bad)
+		}
+	}
+}
+
+func TestNewULIDMonotonic(t *testing.T) {
+	g := &monotonicULID{}
+	ms := uint64(time.Now().UnixMilli())
+
+	prev := g.next(ms)
+	for i := 0; i < 1000; i++ {
+		// 同一毫秒以及时钟回拨时都应严格递增
+		cur := g.next(ms - uint64(i%2))
+		if cur.Compare(prev) <= 0 {
+			t.Fatalf("第 %d 个 ULID %v 未大于前一个 %v", i, cur, prev)
+		}
+		prev = cur
+	}
+
+	// 随机部分溢出时借用下一毫秒
+	g.entropy = [10]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	if next := g.next(ms); next.Timestamp() != ms+1 {
+		t.Errorf("溢出后时间戳 = %d, 期望 %d", next.Timestamp(), ms+1)
+	}
+
+	if a, b := NewULID(), NewULID(); b.Compare(a) <= 0 {
+		t.Errorf("NewULID() 未单调递增: %v, %v", a, b)
+	}
+}
+
+func TestNewULIDMaxTime(t *testing.T) {
+	g := &monotonicULID{}
+	if u := g.next(ulidMaxTime); u.Timestamp() != ulidMaxTime {
+		t.Errorf("最大时间戳 = %d, 期望 %d", u.Timestamp(), uint64(ulidMaxTime))
+	}
+
+	tests := []struct {
+		name string
+		gen  func()
+	}{
+		{"时间戳超过48位", func() { (&monotonicULID{}).next(ulidMaxTime + 1) }},
+		{"随机部分溢出借用的毫秒超过48位", func() {
+			g := &monotonicULID{lastMs: ulidMaxTime}
+			g.entropy = [10]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+			g.next(ulidMaxTime)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("期望 panic")
+				}
+			}()
+			tt.gen()
+		})
+	}
+}
+
+func TestToULID(t *testing.T) {
+	u := MustParseULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	if got := To[ULID]("01arz3ndektsv4rrffq69g5fav"); got != u {
+		t.Errorf("To[ULID](小写) = %v, 期望 %v", got, u)
+	}
+	if got := To[ULID]([16]byte(u)); got != u {
+		t.Errorf("To[ULID]([16]byte) = %v, 期望 %v", got, u)
+	}
+	if got := To[ULID](u[:]); got != u {
+		t.Errorf("To[ULID]([]byte) = %v, 期望 %v", got, u)
+	}
+	if _, err := ToE[ULID]("short"); err == nil {
+		t.Errorf("ToE[ULID](\"short\") 期望返回错误")
+	}
+	if got := To[string](u); got != u.String() {
+		t.Errorf("To[string](ULID) = %q, 期望 %q", got, u.String())
+	}
+}
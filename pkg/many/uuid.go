@@ -0,0 +1,157 @@
+package many
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// UUID 是 RFC 9562 定义的 128 位通用唯一标识符
+type UUID [16]byte
+
+// NilUUID 全 0 的 UUID
+var NilUUID UUID
+
+// NewUUIDv4 生成随机 UUID (version 4)
+func NewUUIDv4() UUID {
+	var u UUID
+	_, _ = rand.Read(u[:])
+	u.setVersion(4)
+	return u
+}
+
+// NewUUIDv7 生成以当前 Unix 毫秒时间戳开头的 UUID (version 7), 按时间大致有序
+func NewUUIDv7() UUID {
+	var u UUID
+	_, _ = rand.Read(u[6:])
+	ms := uint64(time.Now().UnixMilli())
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], ms)
+	copy(u[:6], ts[2:])
+	u.setVersion(7)
+	return u
+}
+
+// ParseUUID 解析 UUID 字符串
+// 支持标准格式 xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, 无连字符的 32 位十六进制,
+// 以及带 {} 或 urn:uuid: 前缀的写法, 不区分大小写
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	raw := s
+	if len(s) == 45 && strings.EqualFold(s[:9], "urn:uuid:") {
+		s = s[9:]
+	} else if len(s) == 38 && s[0] == '{' && s[37] == '}' {
+		s = s[1:37]
+	}
+
+	switch len(s) {
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return NilUUID, fmt.Errorf("invalid UUID format %q", raw)
+		}
+		s = s[:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	case 32:
+	default:
+		return NilUUID, fmt.Errorf("invalid UUID length %d in %q", len(raw), raw)
+	}
+
+	if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+		return NilUUID, fmt.Errorf("invalid UUID %q: %w", raw, err)
+	}
+	return u, nil
+}
+
+// MustParseUUID 与 ParseUUID 相同, 解析失败时 panic
+func MustParseUUID(s string) UUID {
+	u, err := ParseUUID(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// IsValidUUID 判断 s 是否为合法的 UUID 字符串
+func IsValidUUID(s string) bool {
+	_, err := ParseUUID(s)
+	return err == nil
+}
+
+// String 返回小写的标准格式
+func (u UUID) String() string {
+	var buf [36]byte
+	u.encode(buf[:])
+	return string(buf[:])
+}
+
+// Version 返回 UUID 的版本号
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// IsZero 判断是否为 NilUUID
+func (u UUID) IsZero() bool {
+	return u == NilUUID
+}
+
+// Bytes 返回 UUID 的 16 字节切片
+func (u UUID) Bytes() []byte {
+	return u[:]
+}
+
+// MarshalText 实现 encoding.TextMarshaler
+func (u UUID) MarshalText() ([]byte, error) {
+	buf := make([]byte, 36)
+	u.encode(buf)
+	return buf, nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler
+func (u *UUID) UnmarshalText(b []byte) error {
+	parsed, err := ParseUUID(string(b))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+func (u UUID) encode(dst []byte) {
+	hex.Encode(dst[0:8], u[0:4])
+	dst[8] = '-'
+	hex.Encode(dst[9:13], u[4:6])
+	dst[13] = '-'
+	hex.Encode(dst[14:18], u[6:8])
+	dst[18] = '-'
+	hex.Encode(dst[19:23], u[8:10])
+	dst[23] = '-'
+	hex.Encode(dst[24:], u[10:])
+}
+
+func (u *UUID) setVersion(v byte) {
+	u[6] = u[6]&0x0f | v<<4
+	u[8] = u[8]&0x3f | 0x80 // RFC 9562 变体
+}
+
+// toUUIDE 将字符串、[16]byte 或 16 字节的 []byte 转换为 UUID
+func toUUIDE(v any) (UUID, error) {
+	switch val := v.(type) {
+	case nil:
+		return NilUUID, nil
+	case UUID:
+		return val, nil
+	case [16]byte:
+		return UUID(val), nil
+	case []byte:
+		if len(val) == 16 {
+			return UUID(val), nil
+		}
+		return ParseUUID(string(val))
+	}
+	if s, ok := textOf(v); ok {
+		return ParseUUID(s)
+	}
+	return NilUUID, fmt.Errorf("cannot convert %T to UUID", v)
+}
@@ -0,0 +1,89 @@
+package many
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseUUID(t *testing.T) {
+	want := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"标准格式", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", false},
+		{"大写", "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", false},
+		{"无连字符", "6ba7b8109dad11d180b400c04fd430c8", false},
+		{"花括号", "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", false},
+		{"urn前缀", "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", false},
+		{"长度错误", "6ba7b810-9dad-11d1-80b4", true},
+		{"连字符位置错误", "6ba7b8109-dad-11d1-80b4-00c04fd430c8", true},
+		{"非十六进制", "zba7b810-9dad-11d1-80b4-00c04fd430c8", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseUUID(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseUUID(%q) 错误 = %v, 期望错误 = %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != want {
+				t.Errorf("ParseUUID(%q) = %v, 期望 %v", tt.input, got, want)
+			}
+		})
+	}
+
+	if got := want.String(); got != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Errorf("UUID.String() = %q", got)
+	}
+}
+
+func TestNewUUID(t *testing.T) {
+	v4 := NewUUIDv4()
+	if v4.Version() != 4 || v4[8]&0xc0 != 0x80 {
+		t.Errorf("NewUUIDv4() = %v, 版本或变体错误", v4)
+	}
+	if NewUUIDv4() == v4 {
+		t.Errorf("NewUUIDv4() 连续生成了相同的值")
+	}
+
+	v7 := NewUUIDv7()
+	if v7.Version() != 7 || v7[8]&0xc0 != 0x80 {
+		t.Errorf("NewUUIDv7() = %v, 版本或变体错误", v7)
+	}
+	if !IsValidUUID(v7.String()) {
+		t.Errorf("IsValidUUID(%q) = false", v7.String())
+	}
+}
+
+func TestToUUID(t *testing.T) {
+	u := MustParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	if got := To[UUID]("6ba7b8109dad11d180b400c04fd430c8"); got != u {
+		t.Errorf("To[UUID](无连字符) = %v, 期望 %v", got, u)
+	}
+	if got := To[UUID]([16]byte(u)); got != u {
+		t.Errorf("To[UUID]([16]byte) = %v, 期望 %v", got, u)
+	}
+	if got := To[UUID](u[:]); got != u {
+		t.Errorf("To[UUID]([]byte) = %v, 期望 %v", got, u)
+	}
+	if got := To[UUID]([]byte(u.String())); got != u {
+		t.Errorf("To[UUID]([]byte 文本) = %v, 期望 %v", got, u)
+	}
+	if _, err := ToE[UUID]("not-a-uuid"); err == nil {
+		t.Errorf("ToE[UUID](\"not-a-uuid\") 期望返回错误")
+	}
+	if got := To[string](u); got != u.String() {
+		t.Errorf("To[string](UUID) = %q, 期望 %q", got, u.String())
+	}
+
+	// JSON 编解码使用文本形式
+	data, _ := json.Marshal(u)
+	var decoded UUID
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != u {
+		t.Errorf("UUID JSON 往返 = %v, %v", decoded, err)
+	}
+}